@@ -28,15 +28,27 @@ var template string
 var schemaDir string
 var outputLocation string
 var exitCode int
+var pointOverrides string
+var rulesFile string
+var disableRules []string
+var enableRules []string
+var threshold int
+var privateRegistryOnly bool
 
 func init() {
 	scanCmd.Flags().BoolVar(&debug, "debug", false, "turn on debug logs")
 	scanCmd.Flags().BoolVar(&absolutePath, "absolute-path", false, "use the absolute path for the file name")
-	scanCmd.Flags().StringVarP(&format, "format", "f", "json", "Set output format (json, template)")
+	scanCmd.Flags().StringVarP(&format, "format", "f", "json", "Set output format (json, junit, sarif, template)")
 	scanCmd.Flags().StringVar(&schemaDir, "schema-dir", "", "Sets the directory for the json schemas")
 	scanCmd.Flags().StringVarP(&template, "template", "t", "", "Set output template, it will check for a file or read input as the")
 	scanCmd.Flags().StringVarP(&outputLocation, "output", "o", "", "Set output location")
 	scanCmd.Flags().IntVar(&exitCode, "exit-code", 2, "Set the exit-code to use on failure")
+	scanCmd.Flags().IntVar(&threshold, "threshold", 0, "Fail when a resource scores at or below this value")
+	scanCmd.Flags().StringVar(&pointOverrides, "point-overrides", "", "Set a YAML file mapping rule IDs to points")
+	scanCmd.Flags().StringVar(&rulesFile, "rules", "", "Set a YAML file of custom rules to add to the default rules")
+	scanCmd.Flags().StringSliceVar(&disableRules, "disable-rules", nil, "Set rule IDs to skip")
+	scanCmd.Flags().StringSliceVar(&enableRules, "enable-rules", nil, "Set rule IDs to run, skipping all others")
+	scanCmd.Flags().BoolVar(&privateRegistryOnly, "private-registry-only", false, "Flag images without a registry host, for clusters that only pull from private registries")
 	rootCmd.AddCommand(scanCmd)
 }
 
@@ -105,7 +117,34 @@ var scanCmd = &cobra.Command{
 			return err
 		}
 
-		reports, err := ruler.NewRuleset(logger).Run(file.fileName, file.fileBytes, schemaDir)
+		var custom []ruler.Rule
+		if rulesFile != "" {
+			custom, err = ruler.LoadRulesFromFile(rulesFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		rs := ruler.NewRuleset(logger, custom...)
+		rs.Threshold = threshold
+		if privateRegistryOnly {
+			rs.UsePrivateRegistryOnly()
+		}
+		if pointOverrides != "" {
+			overrides, err := ruler.LoadPointOverrides(pointOverrides)
+			if err != nil {
+				return err
+			}
+			rs.ApplyPointOverrides(overrides)
+		}
+		if len(enableRules) > 0 {
+			rs.EnableOnly(enableRules...)
+		}
+		if len(disableRules) > 0 {
+			rs.DisableRules(disableRules...)
+		}
+
+		reports, err := rs.Run(file.fileName, file.fileBytes, schemaDir)
 		if err != nil {
 			return err
 		}
@@ -114,13 +153,7 @@ var scanCmd = &cobra.Command{
 			return fmt.Errorf("invalid input %s", file.fileName)
 		}
 
-		var lowScore bool
-		for _, r := range reports {
-			if r.Score <= 0 {
-				lowScore = true
-				break
-			}
-		}
+		lowScore := ruler.Reports(reports).Failed(threshold)
 
 		var buff bytes.Buffer
 		err = report.WriteReports(format, &buff, reports, template)
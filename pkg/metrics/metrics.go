@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics holds the scan instrumentation of a long-lived badrobot service
+type Metrics struct {
+	Scans        prometheus.Counter
+	FailedScans  prometheus.Counter
+	RuleTriggers *prometheus.CounterVec
+	ScanDuration prometheus.Histogram
+}
+
+// New creates the scan metrics and registers them on reg
+func New(reg *prometheus.Registry) (*Metrics, error) {
+	m := &Metrics{
+		Scans: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "badrobot",
+			Name:      "scans_total",
+			Help:      "Total number of scans.",
+		}),
+		FailedScans: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: "badrobot",
+			Name:      "scans_failed_total",
+			Help:      "Total number of scans that returned an error.",
+		}),
+		RuleTriggers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "badrobot",
+			Name:      "rule_triggers_total",
+			Help:      "Total number of objects each rule matched.",
+		}, []string{"rule"}),
+		ScanDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: "badrobot",
+			Name:      "scan_duration_seconds",
+			Help:      "Duration of scans in seconds.",
+			Buckets:   prometheus.DefBuckets,
+		}),
+	}
+
+	for _, collector := range []prometheus.Collector{m.Scans, m.FailedScans, m.RuleTriggers, m.ScanDuration} {
+		if err := reg.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// Run scans fileBytes with rs.Run and records the scan, its duration, and the rules
+// matched by each report
+func (m *Metrics) Run(rs *ruler.Ruleset, fileName string, fileBytes []byte, schemaDir string) ([]ruler.Report, error) {
+	start := time.Now()
+	reports, err := rs.Run(fileName, fileBytes, schemaDir)
+	m.ScanDuration.Observe(time.Since(start).Seconds())
+
+	m.Scans.Inc()
+	if err != nil {
+		m.FailedScans.Inc()
+		return reports, err
+	}
+
+	m.Observe(reports)
+
+	return reports, nil
+}
+
+// Observe counts the rules matched by each report
+func (m *Metrics) Observe(reports []ruler.Report) {
+	for _, report := range reports {
+		for _, ruleRef := range report.Scoring.Critical {
+			m.RuleTriggers.WithLabelValues(ruleRef.ID).Inc()
+		}
+		for _, ruleRef := range report.Scoring.Passed {
+			m.RuleTriggers.WithLabelValues(ruleRef.ID).Inc()
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/zap"
+)
+
+func TestMetrics_Run(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	m, err := New(prometheus.NewRegistry())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := ruler.NewRuleset(zap.NewNop().Sugar())
+	_, err = m.Run(rs, "operator.yaml", []byte(data), "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = m.Run(rs, "empty.yaml", []byte("---"), "")
+	if err == nil {
+		t.Errorf("Scanning an empty file succeeded when it shouldn't")
+	}
+
+	if got := testutil.ToFloat64(m.Scans); got != 2 {
+		t.Errorf("Got %v scans wanted %v", got, 2)
+	}
+	if got := testutil.ToFloat64(m.FailedScans); got != 1 {
+		t.Errorf("Got %v failed scans wanted %v", got, 1)
+	}
+	if got := testutil.ToFloat64(m.RuleTriggers.WithLabelValues("Privileged")); got != 1 {
+		t.Errorf("Got %v Privileged triggers wanted %v", got, 1)
+	}
+	if got := testutil.CollectAndCount(m.ScanDuration); got != 1 {
+		t.Errorf("Got %v duration histograms wanted %v", got, 1)
+	}
+}
+
+func TestNew_AlreadyRegistered(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	if _, err := New(reg); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if _, err := New(reg); err == nil {
+		t.Errorf("Registering metrics twice succeeded when it shouldn't")
+	}
+}
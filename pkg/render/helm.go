@@ -0,0 +1,84 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+// HelmOptions configure how RenderChart runs helm. Empty fields use the helm executable
+// on the PATH and the badrobot release name.
+type HelmOptions struct {
+	// Binary is the helm executable used to render charts
+	Binary string
+	// ReleaseName is the release name charts are rendered with
+	ReleaseName string
+}
+
+// RenderChart renders the chart at chartPath with helm template and returns the
+// multi-document YAML, ready for Ruleset.Run. values override the chart's values.yaml.
+// Subcharts are rendered from the chart's charts directory, and a chart without a
+// values.yaml renders with its template defaults.
+func RenderChart(chartPath string, values map[string]interface{}, options HelmOptions) ([]byte, error) {
+	if options.Binary == "" {
+		options.Binary = "helm"
+	}
+	if options.ReleaseName == "" {
+		options.ReleaseName = "badrobot"
+	}
+
+	if _, err := os.Stat(filepath.Join(chartPath, "Chart.yaml")); err != nil {
+		return nil, fmt.Errorf("%s is not a Helm chart: %w", chartPath, err)
+	}
+
+	helm, err := exec.LookPath(options.Binary)
+	if err != nil {
+		return nil, fmt.Errorf("rendering %s requires helm: %w", chartPath, err)
+	}
+
+	args := []string{"template", options.ReleaseName, chartPath}
+
+	if len(values) > 0 {
+		valuesBytes, err := yaml.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+
+		valuesFile, err := ioutil.TempFile("", "badrobot-values-*.yaml")
+		if err != nil {
+			return nil, err
+		}
+		defer os.Remove(valuesFile.Name())
+
+		_, err = valuesFile.Write(valuesBytes)
+		if closeErr := valuesFile.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		args = append(args, "--values", valuesFile.Name())
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helm, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, fmt.Errorf("rendering %s: %s", chartPath, message)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,124 @@
+package render
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"go.uber.org/zap"
+)
+
+var chart = map[string]string{
+	"Chart.yaml": `apiVersion: v2
+name: operator
+version: 0.1.0
+`,
+	"values.yaml": `image: controller:v1.0.0
+`,
+	"templates/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: {{ .Values.image }}
+`,
+	"charts/metrics/Chart.yaml": `apiVersion: v2
+name: metrics
+version: 0.1.0
+`,
+	"charts/metrics/templates/service.yaml": `apiVersion: v1
+kind: Service
+metadata:
+  name: metrics
+  namespace: operator-system
+`,
+}
+
+func writeChart(t *testing.T) string {
+	root := filepath.Join(t.TempDir(), "operator")
+	for name, data := range chart {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+	return root
+}
+
+func TestRenderChart(t *testing.T) {
+	if _, err := exec.LookPath("helm"); err != nil {
+		t.Skip("helm is not installed")
+	}
+
+	rendered, err := RenderChart(writeChart(t), map[string]interface{}{"image": "controller:v1.2.3"}, HelmOptions{})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	reports, err := ruler.NewRuleset(zap.NewNop().Sugar()).Run("operator", rendered, "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	objects := make(map[string]bool)
+	for _, report := range reports {
+		objects[report.Object] = true
+	}
+	if !objects["Deployment/controller-manager.operator-system"] {
+		t.Errorf("Got objects %v wanted the rendered Deployment", objects)
+	}
+	if !objects["Service/metrics.operator-system"] {
+		t.Errorf("Got objects %v wanted the subchart Service", objects)
+	}
+}
+
+func TestRenderChart_NotAChart(t *testing.T) {
+	_, err := RenderChart(t.TempDir(), nil, HelmOptions{})
+	if err == nil {
+		t.Errorf("Rendering a directory without Chart.yaml succeeded when it shouldn't")
+	}
+}
+
+func TestRenderChart_Error(t *testing.T) {
+	helm := filepath.Join(t.TempDir(), "helm")
+	err := ioutil.WriteFile(helm, []byte("#!/bin/sh\necho 'Error: template: operator/templates/deployment.yaml: missing value' >&2\nexit 1\n"), 0755)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = RenderChart(writeChart(t), nil, HelmOptions{Binary: helm})
+	if err == nil {
+		t.Fatalf("Rendering succeeded when it shouldn't")
+	}
+	if !strings.Contains(err.Error(), "missing value") {
+		t.Errorf("Got error %q wanted the helm error output", err.Error())
+	}
+}
+
+func TestRenderChart_ReleaseName(t *testing.T) {
+	helm := filepath.Join(t.TempDir(), "helm")
+	err := ioutil.WriteFile(helm, []byte("#!/bin/sh\necho \"# $2\"\n"), 0755)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rendered, err := RenderChart(writeChart(t), nil, HelmOptions{Binary: helm, ReleaseName: "operator"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if strings.TrimSpace(string(rendered)) != "# operator" {
+		t.Errorf("Got %q wanted the chart rendered as release operator", rendered)
+	}
+}
@@ -0,0 +1,21 @@
+package render
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kustomize/api/krusty"
+	"sigs.k8s.io/kustomize/kyaml/filesys"
+)
+
+// BuildKustomization runs kustomize build on dir, resolving its bases and patches, and
+// returns the multi-document YAML, ready for Ruleset.Run
+func BuildKustomization(dir string) ([]byte, error) {
+	kustomizer := krusty.MakeKustomizer(krusty.MakeDefaultOptions())
+
+	resources, err := kustomizer.Run(filesys.MakeFsOnDisk(), dir)
+	if err != nil {
+		return nil, fmt.Errorf("building kustomization %s: %w", dir, err)
+	}
+
+	return resources.AsYaml()
+}
@@ -0,0 +1,99 @@
+package render
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"go.uber.org/zap"
+)
+
+var kustomization = map[string]string{
+	"base/kustomization.yaml": `resources:
+- deployment.yaml
+`,
+	"base/deployment.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: controller:v1.0.0
+`,
+	"overlay/kustomization.yaml": `namespace: operator-system
+resources:
+- ../base
+patchesStrategicMerge:
+- privileged.yaml
+`,
+	"overlay/privileged.yaml": `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+`,
+}
+
+func TestBuildKustomization(t *testing.T) {
+	root := t.TempDir()
+	for name, data := range kustomization {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	built, err := BuildKustomization(filepath.Join(root, "overlay"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	reports, err := ruler.NewRuleset(zap.NewNop().Sugar()).Run("overlay", built, "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(reports) != 1 {
+		t.Fatalf("Got %v reports wanted %v", len(reports), 1)
+	}
+	if reports[0].Object != "Deployment/controller-manager.operator-system" {
+		t.Errorf("Got object %v wanted %v", reports[0].Object, "Deployment/controller-manager.operator-system")
+	}
+
+	var privileged bool
+	for _, ruleRef := range reports[0].Scoring.Critical {
+		if ruleRef.ID == "Privileged" {
+			privileged = true
+		}
+	}
+	if !privileged {
+		t.Errorf("Got no Privileged match wanted the patched securityContext to be scanned")
+	}
+}
+
+func TestBuildKustomization_Invalid(t *testing.T) {
+	root := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(root, "kustomization.yaml"), []byte("resources:\n- missing.yaml\n"), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = BuildKustomization(root)
+	if err == nil {
+		t.Errorf("Building an invalid kustomization succeeded when it shouldn't")
+	}
+}
@@ -0,0 +1,68 @@
+package report
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+)
+
+// InventoryEntry is one scanned Kubernetes object and the rules it violated
+type InventoryEntry struct {
+	Object     string   `json:"object"`
+	Kind       string   `json:"kind"`
+	Name       string   `json:"name"`
+	Namespace  string   `json:"namespace,omitempty"`
+	FileName   string   `json:"fileName"`
+	Score      int      `json:"score"`
+	Violations []string `json:"violations"`
+}
+
+// ToInventory returns a JSON ledger with one entry per scanned object. Kind, name and
+// namespace are parsed from the report object, and violations are the IDs of the
+// negative scoring rules the object matched. Bundle reports are not objects and are left out.
+func ToInventory(reports []ruler.Report) ([]byte, error) {
+	inventory := make([]InventoryEntry, 0, len(reports))
+
+	for _, report := range reports {
+		entry := parseObject(report.Object)
+		if entry.Kind == "Bundle" {
+			continue
+		}
+
+		entry.FileName = report.FileName
+		entry.Score = report.Score
+		entry.Violations = make([]string, 0)
+		for id := range ruleIDs(report.Scoring.Critical) {
+			entry.Violations = append(entry.Violations, id)
+		}
+		sort.Strings(entry.Violations)
+
+		inventory = append(inventory, entry)
+	}
+
+	return json.Marshal(inventory)
+}
+
+// parseObject splits a report object of the form <kind>/<name>.<namespace>, or
+// <kind>/<name> for cluster-scoped kinds. Namespaces cannot contain dots, so the
+// namespace is whatever follows the last one.
+func parseObject(object string) InventoryEntry {
+	entry := InventoryEntry{Object: object}
+
+	kind, name := object, ""
+	if i := strings.Index(object, "/"); i >= 0 {
+		kind, name = object[:i], object[i+1:]
+	}
+	entry.Kind = kind
+
+	if !ruler.IsClusterScoped(kind) {
+		if i := strings.LastIndex(name, "."); i >= 0 {
+			name, entry.Namespace = name[:i], name[i+1:]
+		}
+	}
+	entry.Name = name
+
+	return entry
+}
@@ -0,0 +1,77 @@
+package report
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"go.uber.org/zap"
+)
+
+func TestToInventory(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: manager.config
+  namespace: operator-system
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - "*"
+  resources:
+  - "*"
+  verbs:
+  - "*"
+`
+
+	reports, err := ruler.NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	inventoryBytes, err := ToInventory(reports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var inventory []InventoryEntry
+	if err := json.Unmarshal(inventoryBytes, &inventory); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	want := []InventoryEntry{
+		{Kind: "ConfigMap", Name: "manager.config", Namespace: "operator-system"},
+		{Kind: "CustomResourceDefinition", Name: "widgets.example.com"},
+		{Kind: "ClusterRole", Name: "example-operator"},
+	}
+
+	if len(inventory) != len(want) {
+		t.Fatalf("Got %v entries wanted %v", len(inventory), len(want))
+	}
+	for i, entry := range inventory {
+		if entry.Kind != want[i].Kind || entry.Name != want[i].Name || entry.Namespace != want[i].Namespace {
+			t.Errorf("Got %v/%v in %q wanted %v/%v in %q", entry.Kind, entry.Name, entry.Namespace, want[i].Kind, want[i].Name, want[i].Namespace)
+		}
+		if entry.FileName != "operator.yaml" {
+			t.Errorf("Got file name %v wanted %v", entry.FileName, "operator.yaml")
+		}
+	}
+
+	if len(inventory[2].Violations) == 0 {
+		t.Errorf("Got no violations for the wildcard ClusterRole wanted some")
+	}
+	if inventory[2].Score != reports[2].Score {
+		t.Errorf("Got score %v wanted %v", inventory[2].Score, reports[2].Score)
+	}
+}
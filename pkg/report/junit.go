@@ -0,0 +1,127 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+)
+
+// JUnitTestSuites is the root element of a JUnit XML report
+type JUnitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []JUnitTestSuite `xml:"testsuite"`
+}
+
+type JUnitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []JUnitTestCase `xml:"testcase"`
+}
+
+type JUnitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *JUnitFailure `xml:"failure,omitempty"`
+	Skipped   *JUnitSkipped `xml:"skipped,omitempty"`
+}
+
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type JUnitSkipped struct {
+	Message string `xml:"message,attr"`
+}
+
+// ToJUnit converts the reports to JUnit XML, with a test suite per object and a test
+// case per evaluated rule. Critical rules fail and advise rules are skipped.
+func ToJUnit(reports []ruler.Report) ([]byte, error) {
+	suites := JUnitTestSuites{
+		Name:   "badrobot",
+		Suites: make([]JUnitTestSuite, 0, len(reports)),
+	}
+
+	for _, report := range reports {
+		suite := JUnitTestSuite{
+			Name:      report.Object,
+			TestCases: make([]JUnitTestCase, 0, len(report.Rules)),
+		}
+
+		critical := ruleIDs(report.Scoring.Critical)
+		advise := ruleIDs(report.Scoring.Advise)
+
+		ruleRefs := append([]ruler.RuleRef{}, report.Rules...)
+		sort.Slice(ruleRefs, func(i, j int) bool { return ruleRefs[i].ID < ruleRefs[j].ID })
+
+		for _, ruleRef := range ruleRefs {
+			testCase := JUnitTestCase{
+				Name:      ruleRef.ID,
+				ClassName: report.Object,
+			}
+
+			if critical[ruleRef.ID] {
+				testCase.Failure = &JUnitFailure{
+					Message: ruleRef.Reason,
+					Type:    ruleRef.ID,
+					Text:    fmt.Sprintf("%s matched %s (%v points)", report.FileName, ruleRef.Selector, ruleRef.Points),
+				}
+				suite.Failures++
+			} else if advise[ruleRef.ID] {
+				testCase.Skipped = &JUnitSkipped{Message: ruleRef.Reason}
+				suite.Skipped++
+			}
+
+			suite.TestCases = append(suite.TestCases, testCase)
+			suite.Tests++
+		}
+
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	output, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), output...), nil
+}
+
+func ruleIDs(ruleRefs []ruler.RuleRef) map[string]bool {
+	ids := make(map[string]bool)
+	for _, ruleRef := range ruleRefs {
+		ids[ruleRef.ID] = true
+	}
+	return ids
+}
+
+// JUnitWriter implements result Writer
+type JUnitWriter struct {
+	Output io.Writer
+}
+
+// Write writes the reports in JUnit XML format
+func (jw JUnitWriter) Write(reports reports) error {
+	output, err := ToJUnit(reports)
+	if err != nil {
+		return err
+	}
+
+	if _, err = fmt.Fprint(jw.Output, string(output)); err != nil {
+		return err
+	}
+	return nil
+}
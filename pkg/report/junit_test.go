@@ -0,0 +1,104 @@
+package report
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"go.uber.org/zap"
+)
+
+func TestToJUnit(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+`
+
+	reports, err := ruler.NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	output, err := ToJUnit(reports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var suites JUnitTestSuites
+	if err := xml.Unmarshal(output, &suites); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(suites.Suites) != len(reports) {
+		t.Fatalf("Got %v suites wanted %v", len(suites.Suites), len(reports))
+	}
+
+	for i, suite := range suites.Suites {
+		report := reports[i]
+		if suite.Name != report.Object {
+			t.Errorf("Got suite %v wanted %v", suite.Name, report.Object)
+		}
+		if len(suite.TestCases) != len(report.Rules) || suite.Tests != len(report.Rules) {
+			t.Errorf("Got %v cases wanted %v", len(suite.TestCases), len(report.Rules))
+		}
+		if suite.Failures != len(report.Scoring.Critical) {
+			t.Errorf("Got %v failures wanted %v", suite.Failures, len(report.Scoring.Critical))
+		}
+		if suite.Skipped != len(report.Scoring.Advise) {
+			t.Errorf("Got %v skipped wanted %v", suite.Skipped, len(report.Scoring.Advise))
+		}
+	}
+
+	deployment := suites.Suites[0]
+	if deployment.Failures == 0 {
+		t.Errorf("Got %v failures wanted many", deployment.Failures)
+	}
+	for _, testCase := range deployment.TestCases {
+		if testCase.Name == "Privileged" && (testCase.Failure == nil || testCase.Failure.Message == "") {
+			t.Errorf("Got failure %v wanted the rule reason", testCase.Failure)
+		}
+	}
+}
+
+func TestToJUnit_Passing(t *testing.T) {
+	reports := []ruler.Report{{
+		Object: "Namespace/operator-system",
+		Rules: []ruler.RuleRef{
+			{ID: "DefaultNamespace", Points: -1},
+			{ID: "KubeSystemNamespace", Points: -9},
+		},
+	}}
+
+	output, err := ToJUnit(reports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var suites JUnitTestSuites
+	if err := xml.Unmarshal(output, &suites); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if suites.Tests != 2 {
+		t.Errorf("Got %v tests wanted %v", suites.Tests, 2)
+	}
+	if suites.Failures != 0 {
+		t.Errorf("Got %v failures wanted %v", suites.Failures, 0)
+	}
+}
@@ -0,0 +1,152 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+)
+
+const (
+	sarifVersion = "2.1.0"
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolURI = "https://github.com/controlplaneio/badrobot"
+)
+
+// SARIF is the subset of the SARIF 2.1.0 log format badrobot emits
+type SARIF struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SARIFRun `json:"runs"`
+}
+
+type SARIFRun struct {
+	Tool    SARIFTool     `json:"tool"`
+	Results []SARIFResult `json:"results"`
+}
+
+type SARIFTool struct {
+	Driver SARIFDriver `json:"driver"`
+}
+
+type SARIFDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []SARIFRule `json:"rules"`
+}
+
+type SARIFRule struct {
+	ID               string       `json:"id"`
+	ShortDescription SARIFMessage `json:"shortDescription"`
+	HelpURI          string       `json:"helpUri,omitempty"`
+}
+
+type SARIFResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   SARIFMessage    `json:"message"`
+	Locations []SARIFLocation `json:"locations"`
+}
+
+type SARIFMessage struct {
+	Text string `json:"text"`
+}
+
+type SARIFLocation struct {
+	PhysicalLocation SARIFPhysicalLocation `json:"physicalLocation"`
+}
+
+type SARIFPhysicalLocation struct {
+	ArtifactLocation SARIFArtifactLocation `json:"artifactLocation"`
+}
+
+type SARIFArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// ToSARIF converts the negative scoring rules of the reports to a SARIF log
+func ToSARIF(reports []ruler.Report) ([]byte, error) {
+	driver := SARIFDriver{
+		Name:           "badrobot",
+		InformationURI: sarifToolURI,
+		Rules:          make([]SARIFRule, 0),
+	}
+	results := make([]SARIFResult, 0)
+	ruleIndex := make(map[string]int)
+
+	for _, report := range reports {
+		for _, ruleRef := range report.Scoring.Critical {
+			if ruleRef.Points >= 0 {
+				continue
+			}
+
+			index, ok := ruleIndex[ruleRef.ID]
+			if !ok {
+				index = len(driver.Rules)
+				ruleIndex[ruleRef.ID] = index
+				driver.Rules = append(driver.Rules, SARIFRule{
+					ID:               ruleRef.ID,
+					ShortDescription: SARIFMessage{Text: ruleRef.Reason},
+					HelpURI:          ruleRef.Link,
+				})
+			}
+
+			results = append(results, SARIFResult{
+				RuleID:    ruleRef.ID,
+				RuleIndex: index,
+				Level:     sarifLevel(ruleRef.Severity),
+				Message:   SARIFMessage{Text: fmt.Sprintf("%s: %s (%v points)", report.Object, ruleRef.Reason, ruleRef.Points)},
+				Locations: []SARIFLocation{{
+					PhysicalLocation: SARIFPhysicalLocation{
+						ArtifactLocation: SARIFArtifactLocation{URI: report.FileName},
+					},
+				}},
+			})
+		}
+	}
+
+	return json.Marshal(SARIF{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs: []SARIFRun{{
+			Tool:    SARIFTool{Driver: driver},
+			Results: results,
+		}},
+	})
+}
+
+// sarifLevel maps a rule severity to a SARIF result level
+func sarifLevel(severity ruler.Severity) string {
+	switch severity {
+	case ruler.SeverityCritical, ruler.SeverityHigh:
+		return "error"
+	case ruler.SeverityMedium:
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// SARIFWriter implements result Writer
+type SARIFWriter struct {
+	Output io.Writer
+}
+
+// Write writes the reports in SARIF format
+func (sw SARIFWriter) Write(reports reports) error {
+	output, err := ToSARIF(reports)
+	if err != nil {
+		return err
+	}
+
+	formattedOutput, err := PrettyJSON(output)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprint(sw.Output, string(formattedOutput)); err != nil {
+		return err
+	}
+	return nil
+}
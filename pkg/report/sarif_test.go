@@ -0,0 +1,133 @@
+package report
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+)
+
+var sarifReports = []ruler.Report{
+	{
+		Object:   "Deployment/controller-manager.operator-system",
+		FileName: "operator.yaml",
+		Score:    -13,
+		Scoring: ruler.RuleScoring{
+			Critical: []ruler.RuleRef{
+				{ID: "Privileged", Reason: "Operators should not deploy with privileged: true", Points: -16, Severity: ruler.SeverityHigh},
+				{ID: "UnmountedHostPathVolume", Reason: "A hostPath volume is defined but not mounted by any container", Points: -1, Severity: ruler.SeverityMedium},
+			},
+			Passed: []ruler.RuleRef{
+				{ID: "SeccompProfile", Reason: "A seccomp profile reduces the syscall attack surface", Points: 3, Severity: ruler.SeverityInfo},
+			},
+		},
+	},
+	{
+		Object:   "ClusterRole/example-operator",
+		FileName: "rbac.yaml",
+		Score:    -22,
+		Scoring: ruler.RuleScoring{
+			Critical: []ruler.RuleRef{
+				{ID: "Privileged", Reason: "Operators should not deploy with privileged: true", Points: -16, Severity: ruler.SeverityHigh},
+				{ID: "CustomResourceClusterRole", Reason: "The Operator SA cluster role has permissions over any Custom Resource", Link: "https://example.com/crd", Points: -6, Severity: ruler.SeverityMedium},
+			},
+		},
+	},
+}
+
+func TestToSARIF(t *testing.T) {
+	output, err := ToSARIF(sarifReports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var log SARIF
+	if err := json.Unmarshal(output, &log); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if log.Version != "2.1.0" {
+		t.Errorf("Got version %v wanted %v", log.Version, "2.1.0")
+	}
+	if len(log.Runs) != 1 {
+		t.Fatalf("Got %v runs wanted %v", len(log.Runs), 1)
+	}
+
+	run := log.Runs[0]
+	if run.Tool.Driver.Name != "badrobot" {
+		t.Errorf("Got driver %v wanted %v", run.Tool.Driver.Name, "badrobot")
+	}
+	if len(run.Tool.Driver.Rules) != 3 {
+		t.Errorf("Got %v rules wanted %v", len(run.Tool.Driver.Rules), 3)
+	}
+	if len(run.Results) != 4 {
+		t.Fatalf("Got %v results wanted %v", len(run.Results), 4)
+	}
+
+	wantLevels := []string{"error", "warning", "error", "warning"}
+	wantURIs := []string{"operator.yaml", "operator.yaml", "rbac.yaml", "rbac.yaml"}
+	for i, result := range run.Results {
+		if result.Level != wantLevels[i] {
+			t.Errorf("Got level %v wanted %v for %v", result.Level, wantLevels[i], result.RuleID)
+		}
+		if len(result.Locations) != 1 || result.Locations[0].PhysicalLocation.ArtifactLocation.URI != wantURIs[i] {
+			t.Errorf("Got locations %v wanted %v", result.Locations, wantURIs[i])
+		}
+
+		rule := run.Tool.Driver.Rules[result.RuleIndex]
+		if rule.ID != result.RuleID {
+			t.Errorf("Got rule %v at index %v wanted %v", rule.ID, result.RuleIndex, result.RuleID)
+		}
+	}
+
+	crd := run.Tool.Driver.Rules[2]
+	if crd.HelpURI != "https://example.com/crd" || crd.ShortDescription.Text == "" {
+		t.Errorf("Got rule metadata %v wanted reason and link", crd)
+	}
+
+	// the fields code scanning depends on must be present in the raw document
+	var raw map[string]interface{}
+	if err := json.Unmarshal(output, &raw); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, field := range []string{"version", "$schema", "runs"} {
+		if _, ok := raw[field]; !ok {
+			t.Errorf("Got no %v field wanted one", field)
+		}
+	}
+}
+
+func TestToSARIF_LevelFollowsSeverity(t *testing.T) {
+	reports := []ruler.Report{{
+		Object: "Deployment/controller-manager.operator-system",
+		Scoring: ruler.RuleScoring{
+			Critical: []ruler.RuleRef{{ID: "Privileged", Points: -16, Severity: ruler.SeverityMedium}},
+		},
+	}}
+
+	output, err := ToSARIF(reports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var log SARIF
+	if err := json.Unmarshal(output, &log); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if level := log.Runs[0].Results[0].Level; level != "warning" {
+		t.Errorf("Got level %v wanted %v", level, "warning")
+	}
+}
+
+func TestWriteReports_SARIF(t *testing.T) {
+	var buff bytes.Buffer
+	if err := WriteReports("sarif", &buff, sarifReports, ""); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !json.Valid(buff.Bytes()) {
+		t.Errorf("Got invalid JSON output %v", buff.String())
+	}
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+)
+
+// Summary aggregates the reports of one or more scanned files
+type Summary struct {
+	Objects       int            `json:"objects"`
+	Passed        int            `json:"passed"`
+	Failed        int            `json:"failed"`
+	LowestScoring *ObjectScore   `json:"lowestScoring,omitempty"`
+	RuleTriggers  map[string]int `json:"ruleTriggers"`
+}
+
+// ObjectScore identifies a scanned object and its score
+type ObjectScore struct {
+	Object   string `json:"object"`
+	FileName string `json:"fileName"`
+	Score    int    `json:"score"`
+}
+
+// Summarize aggregates the reports of each scanned file. An object passes when its
+// score is not negative, and RuleTriggers counts the objects that matched each
+// negative scoring rule.
+func Summarize(all [][]ruler.Report) Summary {
+	summary := Summary{
+		RuleTriggers: make(map[string]int),
+	}
+
+	for _, reports := range all {
+		for _, report := range reports {
+			summary.Objects++
+			if report.Score >= 0 {
+				summary.Passed++
+			} else {
+				summary.Failed++
+			}
+
+			if summary.LowestScoring == nil || report.Score < summary.LowestScoring.Score {
+				summary.LowestScoring = &ObjectScore{
+					Object:   report.Object,
+					FileName: report.FileName,
+					Score:    report.Score,
+				}
+			}
+
+			for id := range ruleIDs(report.Scoring.Critical) {
+				summary.RuleTriggers[id]++
+			}
+		}
+	}
+
+	return summary
+}
@@ -0,0 +1,104 @@
+package report
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/controlplaneio/badrobot/pkg/ruler"
+	"go.uber.org/zap"
+)
+
+func TestSummarize(t *testing.T) {
+	files := map[string]string{
+		"operator.yaml": `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+`,
+		"rbac.yaml": `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - "*"
+  resources:
+  - "*"
+  verbs:
+  - "*"
+`,
+		"privileged.yaml": `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: privileged
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`,
+	}
+
+	rs := ruler.NewRuleset(zap.NewNop().Sugar())
+	all := make([][]ruler.Report, 0)
+	for _, fileName := range []string{"operator.yaml", "rbac.yaml", "privileged.yaml"} {
+		reports, err := rs.Run(fileName, []byte(files[fileName]), "")
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		all = append(all, reports)
+	}
+
+	summary := Summarize(all)
+
+	if summary.Objects != 4 {
+		t.Errorf("Got %v objects wanted %v", summary.Objects, 4)
+	}
+	if summary.Passed != 1 || summary.Failed != 3 {
+		t.Errorf("Got %v passed and %v failed wanted %v and %v", summary.Passed, summary.Failed, 1, 3)
+	}
+	if summary.LowestScoring == nil || summary.LowestScoring.Object != "ClusterRole/example-operator" {
+		t.Errorf("Got lowest scoring %v wanted %v", summary.LowestScoring, "ClusterRole/example-operator")
+	}
+	if summary.RuleTriggers["Privileged"] != 2 {
+		t.Errorf("Got %v Privileged triggers wanted %v", summary.RuleTriggers["Privileged"], 2)
+	}
+	if summary.RuleTriggers["StarAllClusterRole"] != 1 {
+		t.Errorf("Got %v StarAllClusterRole triggers wanted %v", summary.RuleTriggers["StarAllClusterRole"], 1)
+	}
+
+	output, err := json.Marshal(summary)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(output, &raw); err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, field := range []string{"objects", "passed", "failed", "lowestScoring", "ruleTriggers"} {
+		if _, ok := raw[field]; !ok {
+			t.Errorf("Got no %v field wanted one", field)
+		}
+	}
+}
@@ -29,6 +29,10 @@ func WriteReports(format string, output io.Writer, reports reports, outputTempla
 	switch format {
 	case "json":
 		writer = &JSONWriter{Output: output}
+	case "sarif":
+		writer = &SARIFWriter{Output: output}
+	case "junit":
+		writer = &JUnitWriter{Output: output}
 	case "template":
 		var err error
 		if len(outputTemplate) == 0 {
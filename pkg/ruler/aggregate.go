@@ -0,0 +1,207 @@
+package ruler
+
+import (
+	"encoding/json"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AggregateRule is evaluated once across every document of a bundle, after the
+// per-document reports have been generated. docs[i] is the JSON of reports[i].
+type AggregateRule struct {
+	Selector    string
+	ID          string
+	Title       string
+	Reason      string
+	Remediation string
+	Link        string
+	Category    string
+	Points      int
+	Weight      int
+	// Advisory rules report a match without changing the score of the bundle
+	Advisory  bool
+	Predicate func([]Report, [][]byte) int
+}
+
+func defaultAggregateRules() []AggregateRule {
+	list := make([]AggregateRule, 0)
+
+	// OPR-R33-BUNDLE - required ConfigMap or Secret is not defined in the bundle
+	missingRequiredConfigRefRule := AggregateRule{
+		Predicate:   MissingRequiredConfigRef,
+		ID:          "MissingRequiredConfigRef",
+		Selector:    ".volumes[] .configMap .secret .env[] .valueFrom .envFrom[]",
+		Reason:      "A workload requires a ConfigMap or Secret that is not defined in the bundle and will fail to start without it",
+		Remediation: "Add the ConfigMap or Secret to the bundle, or mark the reference optional: true",
+		Link:        "https://kubernetes.io/docs/concepts/configuration/overview/",
+		Category:    CategoryCorrectness,
+		Points:      -1,
+		Advisory:    true,
+	}
+	list = append(list, missingRequiredConfigRefRule)
+
+	// OPR-R36-BUNDLE - ClusterRole with full permissions over all resources is bound cluster-wide
+	wildcardRoleWildcardBindingRule := AggregateRule{
+		Predicate:   WildcardRoleWildcardBinding,
+		ID:          "WildcardRoleWildcardBinding",
+		Selector:    "ClusterRoleBinding .roleRef .name == ClusterRole .rules[] * * *",
+		Reason:      "A ClusterRole with full permissions on all resources is bound cluster-wide by a ClusterRoleBinding in the bundle",
+		Remediation: "Replace the * rules with the resources and verbs the Operator uses, or bind the role in a namespace with a RoleBinding",
+		Link:        "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Category:    CategoryRBAC,
+		Points:      -30,
+	}
+	list = append(list, wildcardRoleWildcardBindingRule)
+
+	// OPR-R74-BUNDLE - role is never bound or binding references a role not in the bundle
+	unboundOrDanglingRBACRule := AggregateRule{
+		Predicate:   UnboundOrDanglingRBAC,
+		ID:          "UnboundOrDanglingRBAC",
+		Selector:    "ClusterRole .metadata .name != RoleBinding .roleRef .name",
+		Reason:      "A role in the bundle is never bound, or a binding references a role that is not defined in the bundle",
+		Remediation: "Remove the unused role, or add the role the binding references to the bundle",
+		Link:        "https://kubernetes.io/docs/reference/access-authn-authz/rbac/",
+		Category:    CategoryCorrectness,
+		Points:      -1,
+		Advisory:    true,
+	}
+	list = append(list, unboundOrDanglingRBACRule)
+
+	// OPR-R88-BUNDLE - imagePullSecret is not defined in the bundle
+	danglingImagePullSecretRule := AggregateRule{
+		Predicate:   DanglingImagePullSecret,
+		ID:          "DanglingImagePullSecret",
+		Selector:    ".spec .imagePullSecrets[] .name != Secret .metadata .name",
+		Reason:      "A workload pulls images with a Secret that is not defined in the bundle and will fail to pull private images without it",
+		Remediation: "Add the pull Secret to the bundle, or add its name to ruler.KnownImagePullSecrets",
+		Link:        "https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod",
+		Category:    CategoryCorrectness,
+		Points:      -1,
+		Advisory:    true,
+	}
+	list = append(list, danglingImagePullSecretRule)
+
+	// OPR-R91-BUNDLE - Service exposes an admin or metrics port without a NetworkPolicy
+	unprotectedAdminServiceRule := AggregateRule{
+		Predicate:   UnprotectedAdminService,
+		ID:          "UnprotectedAdminService",
+		Selector:    "Service .spec .ports[] .port != NetworkPolicy .spec .podSelector",
+		Reason:      "A Service exposes an admin or metrics port but no NetworkPolicy in the bundle restricts ingress to its pods",
+		Remediation: "Add a NetworkPolicy that selects the Service pods and restricts ingress",
+		Link:        "https://kubernetes.io/docs/concepts/services-networking/network-policies/",
+		Category:    CategoryNamespace,
+		Points:      -1,
+		Advisory:    true,
+	}
+	list = append(list, unprotectedAdminServiceRule)
+
+	// OPR-R94-BUNDLE - workload mounts the token of a highly privileged service account by default
+	defaultMountPrivilegedSARule := AggregateRule{
+		Predicate:   DefaultMountPrivilegedSA,
+		ID:          "DefaultMountPrivilegedSA",
+		Selector:    "ClusterRoleBinding .subjects[] ServiceAccount == .spec .serviceAccountName && .automountServiceAccountToken unset",
+		Reason:      "A workload mounts the token of a service account bound to a highly privileged ClusterRole without opting in, so any compromise of the pod exposes the cluster",
+		Remediation: "Set automountServiceAccountToken: false on the workload or the ServiceAccount, and mount the token explicitly only where the Operator needs it",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+		Category:    CategoryRBAC,
+		Points:      -30,
+	}
+	list = append(list, defaultMountPrivilegedSARule)
+
+	return list
+}
+
+// generateBundleReport evaluates the aggregate rules over a bundle. The report is
+// only meaningful when at least one aggregate rule matched.
+func (rs *Ruleset) generateBundleReport(fileName string, reports []Report, docs [][]byte) (Report, bool) {
+	report := Report{
+		Object:   "Bundle/" + fileName,
+		Valid:    true,
+		FileName: fileName,
+		Bundle:   true,
+		Score:    0,
+		Rules:    make([]RuleRef, 0),
+		Scoring: RuleScoring{
+			Advise:   make([]RuleRef, 0),
+			Passed:   make([]RuleRef, 0),
+			Critical: make([]RuleRef, 0),
+		},
+	}
+
+	var matched bool
+	for _, rule := range rs.AggregateRules {
+		ruleRef := RuleRef{
+			Containers:  rule.Predicate(reports, docs),
+			ID:          rule.ID,
+			Points:      rule.Points,
+			Reason:      rule.Reason,
+			Remediation: rule.Remediation,
+			Selector:    rule.Selector,
+			Weight:      rule.Weight,
+			Link:        rule.Link,
+			Category:    rule.Category,
+			Severity:    severityFor(rule.Points),
+			Advisory:    rule.Advisory,
+		}
+
+		if ruleRef.Containers > 0 {
+			matched = true
+		}
+
+		rs.scoreRule(&report, ruleRef)
+	}
+
+	setScoreMessage(&report, rs.Threshold)
+	sortScoring(&report)
+
+	return report, matched
+}
+
+// bundleObject is the subset of a bundled document the aggregate rules correlate on
+type bundleObject struct {
+	Kind     string            `json:"kind"`
+	Metadata metav1.ObjectMeta `json:"metadata"`
+	Spec     json.RawMessage   `json:"spec,omitempty"`
+}
+
+// parseBundleObject decodes a document, returning false if it is not a Kubernetes object
+func parseBundleObject(doc []byte) (*bundleObject, bool) {
+	object := &bundleObject{}
+	err := json.Unmarshal(doc, object)
+	if err != nil || object.Kind == "" {
+		return nil, false
+	}
+
+	return object, true
+}
+
+// podSpec returns the pod spec of a Pod or of a workload's pod template
+func (o *bundleObject) podSpec() *corev1.PodSpec {
+	if len(o.Spec) == 0 {
+		return nil
+	}
+
+	switch o.Kind {
+	case "Pod":
+		spec := &corev1.PodSpec{}
+		if json.Unmarshal(o.Spec, spec) == nil {
+			return spec
+		}
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		spec := &struct {
+			Template corev1.PodTemplateSpec `json:"template"`
+		}{}
+		if json.Unmarshal(o.Spec, spec) == nil {
+			return &spec.Template.Spec
+		}
+	case "CronJob":
+		spec := &batchv1.CronJobSpec{}
+		if json.Unmarshal(o.Spec, spec) == nil {
+			return &spec.JobTemplate.Spec.Template.Spec
+		}
+	}
+
+	return nil
+}
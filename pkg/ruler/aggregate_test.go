@@ -0,0 +1,174 @@
+package ruler
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+// bundleDocs converts YAML documents to the JSON documents and reports an aggregate rule receives
+func bundleDocs(t *testing.T, data ...string) ([]Report, [][]byte) {
+	reports := make([]Report, 0)
+	docs := make([][]byte, 0)
+	for _, d := range data {
+		json, err := yaml.YAMLToJSON([]byte(d))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		reports = append(reports, Report{Valid: true})
+		docs = append(docs, json)
+	}
+	return reports, docs
+}
+
+func TestRuleset_Run_BundleReport(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        envFrom:
+        - configMapRef:
+            name: manager-config
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("Got %v reports wanted %v", len(reports), 3)
+	}
+
+	bundle := reports[2]
+	if bundle.Object != "Bundle/operator.yaml" {
+		t.Errorf("Got object %v wanted %v", bundle.Object, "Bundle/operator.yaml")
+	}
+	if len(bundle.Scoring.Critical) != 1 || bundle.Scoring.Critical[0].ID != "MissingRequiredConfigRef" {
+		t.Fatalf("Got critical rules %v wanted %v", bundle.Scoring.Critical, "MissingRequiredConfigRef")
+	}
+	if !bundle.Scoring.Critical[0].Advisory {
+		t.Errorf("Got MissingRequiredConfigRef not advisory")
+	}
+	if bundle.Score != 0 {
+		t.Errorf("Got score %v wanted %v", bundle.Score, 0)
+	}
+	if Reports([]Report{bundle}).Failed(5) {
+		t.Errorf("Got failed bundle report with only advisory findings at threshold %v", 5)
+	}
+}
+
+func TestRuleset_Run_BundleReport_AdvisoryIgnoresSeverity(t *testing.T) {
+	previous := HighPoints
+	HighPoints = 0
+	t.Cleanup(func() { HighPoints = previous })
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	reports, docs := bundleDocs(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        envFrom:
+        - configMapRef:
+            name: manager-config
+`, `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+`)
+
+	bundle, _ := rs.generateBundleReport("operator.yaml", reports, docs)
+	if bundle.Score != 0 {
+		t.Errorf("Got score %v wanted %v", bundle.Score, 0)
+	}
+}
+
+func TestRuleset_Run_BundleReportCritical(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operator
+rules:
+- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["*"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: operator
+subjects:
+- kind: Group
+  name: system:authenticated
+  apiGroup: rbac.authorization.k8s.io
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	bundle := reports[len(reports)-1]
+	if !bundle.Bundle {
+		t.Fatalf("Got object %v wanted a bundle report", bundle.Object)
+	}
+	if len(bundle.Scoring.Critical) == 0 {
+		t.Errorf("Got no critical rules wanted %v", "WildcardRoleWildcardBinding")
+	}
+	if !Reports([]Report{bundle}).Failed(0) {
+		t.Errorf("Got passed bundle report with score %v", bundle.Score)
+	}
+}
+
+func TestRuleset_Run_NoBundleReport(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(reports) != 2 {
+		t.Errorf("Got %v reports wanted %v", len(reports), 2)
+	}
+}
@@ -0,0 +1,77 @@
+package ruler
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/controlplaneio/badrobot/pkg/rules"
+	"github.com/ghodss/yaml"
+)
+
+// customRule is a rule definition read from a custom rule file
+type customRule struct {
+	ID          string   `json:"id"`
+	Predicate   string   `json:"predicate"`
+	Selector    string   `json:"selector"`
+	Reason      string   `json:"reason"`
+	Remediation string   `json:"remediation"`
+	Link        string   `json:"link"`
+	Category    string   `json:"category"`
+	Kinds       []string `json:"kinds"`
+	Points      int      `json:"points"`
+	Advise      int      `json:"advise"`
+	Weight      int      `json:"weight"`
+}
+
+// LoadRulesFromFile reads a YAML or JSON file of rule definitions. Each rule names a
+// predicate registered in rules.Registry, e.g.
+//
+//	# rules.yaml
+//	- id: PrivilegedOperator
+//	  predicate: Privileged
+//	  selector: containers[] .securityContext .privileged == true
+//	  reason: Privileged operators are not allowed in this organisation
+//	  kinds: [Deployment]
+//	  points: -30
+func LoadRulesFromFile(path string) ([]Rule, error) {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	definitions := make([]customRule, 0)
+	if err := yaml.Unmarshal(fileBytes, &definitions); err != nil {
+		return nil, fmt.Errorf("parsing rules file %s: %w", path, err)
+	}
+
+	list := make([]Rule, 0, len(definitions))
+	for i, definition := range definitions {
+		if definition.ID == "" {
+			return nil, fmt.Errorf("rule %d in %s has no id", i, path)
+		}
+
+		predicate, ok := rules.Lookup(definition.Predicate)
+		if !ok {
+			return nil, fmt.Errorf("rule %s in %s references unknown predicate %q", definition.ID, path, definition.Predicate)
+		}
+
+		parsed, _ := rules.LookupParsed(definition.Predicate)
+
+		list = append(list, Rule{
+			Predicate:       predicate,
+			ParsedPredicate: parsed,
+			ID:              definition.ID,
+			Selector:        definition.Selector,
+			Reason:          definition.Reason,
+			Remediation:     definition.Remediation,
+			Link:            definition.Link,
+			Category:        definition.Category,
+			Kinds:           definition.Kinds,
+			Points:          definition.Points,
+			Advise:          definition.Advise,
+			Weight:          definition.Weight,
+		})
+	}
+
+	return list, nil
+}
@@ -0,0 +1,141 @@
+package ruler
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+func writeRulesFile(t *testing.T, data string) string {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	err := ioutil.WriteFile(path, []byte(data), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return path
+}
+
+func TestLoadRulesFromFile(t *testing.T) {
+	path := writeRulesFile(t, `
+- id: PrivilegedOperator
+  predicate: Privileged
+  selector: containers[] .securityContext .privileged == true
+  reason: Privileged operators are not allowed in this organisation
+  link: https://example.com/policy/privileged
+  kinds:
+  - Pod
+  points: -50
+  weight: 1
+`)
+
+	custom, err := LoadRulesFromFile(path)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(custom) != 1 {
+		t.Fatalf("Got %v rules wanted %v", len(custom), 1)
+	}
+	if custom[0].ID != "PrivilegedOperator" || custom[0].Points != -50 || custom[0].Link != "https://example.com/policy/privileged" {
+		t.Errorf("Got rule %+v wanted the definition from the file", custom[0])
+	}
+
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := NewRuleset(zap.NewNop().Sugar(), custom...).generateReport("operator.yaml", json, schemaDir)
+
+	var found bool
+	for _, ruleRef := range report.Scoring.Critical {
+		if ruleRef.ID == "PrivilegedOperator" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Got critical rules %v wanted %v", report.Scoring.Critical, "PrivilegedOperator")
+	}
+}
+
+func TestNewRuleset_ExtraRulesLast(t *testing.T) {
+	defaults := NewRuleset(zap.NewNop().Sugar()).Rules
+	extra := Rule{ID: "PrivilegedOperator", Predicate: defaults[0].Predicate}
+
+	rs := NewRuleset(zap.NewNop().Sugar(), extra)
+	if len(rs.Rules) != len(defaults)+1 {
+		t.Fatalf("Got %v rules wanted %v", len(rs.Rules), len(defaults)+1)
+	}
+	if last := rs.Rules[len(rs.Rules)-1].ID; last != "PrivilegedOperator" {
+		t.Errorf("Got last rule %v wanted %v", last, "PrivilegedOperator")
+	}
+}
+
+func TestLoadRulesFromFile_UnknownPredicate(t *testing.T) {
+	path := writeRulesFile(t, `
+- id: Custom
+  predicate: DoesNotExist
+  kinds:
+  - Pod
+  points: -1
+`)
+
+	_, err := LoadRulesFromFile(path)
+	if err == nil {
+		t.Fatalf("Loading rules succeeded when it shouldn't")
+	}
+	if !strings.Contains(err.Error(), `unknown predicate "DoesNotExist"`) {
+		t.Errorf("Got error %v wanted an unknown predicate error", err)
+	}
+}
+
+func TestLoadRulesFromFile_Malformed(t *testing.T) {
+	path := writeRulesFile(t, `
+- id: Custom
+  predicate: Privileged
+  points: [not, a, number
+`)
+
+	_, err := LoadRulesFromFile(path)
+	if err == nil {
+		t.Errorf("Loading rules succeeded when it shouldn't")
+	}
+}
+
+func TestLoadRulesFromFile_ParsedPredicate(t *testing.T) {
+	path := writeRulesFile(t, `
+- id: AllCapabilities
+  predicate: AddAllCapabilities
+  reason: Operators must not add all capabilities
+  kinds:
+  - Deployment
+  points: -20
+`)
+
+	custom, err := LoadRulesFromFile(path)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if custom[0].ParsedPredicate == nil {
+		t.Errorf("Got no parsed predicate for rule %v of predicate %v", custom[0].ID, "AddAllCapabilities")
+	}
+}
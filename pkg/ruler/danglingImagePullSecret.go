@@ -0,0 +1,43 @@
+// OPR-R88-BUNDLE - imagePullSecret is not defined in the bundle
+package ruler
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// KnownImagePullSecrets are pull secrets provisioned outside the bundle, such as by a
+// cluster administrator, that workloads may reference without defining
+var KnownImagePullSecrets = []string{}
+
+func DanglingImagePullSecret(reports []Report, docs [][]byte) int {
+	secrets := make(map[string]bool)
+	podSpecs := make([]*corev1.PodSpec, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		if object.Kind == "Secret" {
+			secrets[object.Metadata.Name] = true
+		} else if podSpec := object.podSpec(); podSpec != nil {
+			podSpecs = append(podSpecs, podSpec)
+		}
+	}
+
+	missing := make(map[string]bool)
+	for _, podSpec := range podSpecs {
+		for _, pullSecret := range podSpec.ImagePullSecrets {
+			if !secrets[pullSecret.Name] && !containsString(KnownImagePullSecrets, pullSecret.Name) {
+				missing[pullSecret.Name] = true
+			}
+		}
+	}
+
+	return len(missing)
+}
@@ -0,0 +1,57 @@
+package ruler
+
+import (
+	"testing"
+)
+
+const pullSecretDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+spec:
+  template:
+    spec:
+      imagePullSecrets:
+      - name: registry-credentials
+      containers:
+      - name: manager
+        image: registry.example.com/controller:v1.0.0
+`
+
+func Test_DanglingImagePullSecret_Dangling(t *testing.T) {
+	reports, docs := bundleDocs(t, pullSecretDeployment)
+
+	secrets := DanglingImagePullSecret(reports, docs)
+	if secrets != 1 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 1)
+	}
+}
+
+func Test_DanglingImagePullSecret_Defined(t *testing.T) {
+	reports, docs := bundleDocs(t, pullSecretDeployment, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: registry-credentials
+type: kubernetes.io/dockerconfigjson
+`)
+
+	secrets := DanglingImagePullSecret(reports, docs)
+	if secrets != 0 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 0)
+	}
+}
+
+func Test_DanglingImagePullSecret_Known(t *testing.T) {
+	previous := KnownImagePullSecrets
+	KnownImagePullSecrets = []string{"registry-credentials"}
+	defer func() { KnownImagePullSecrets = previous }()
+
+	reports, docs := bundleDocs(t, pullSecretDeployment)
+
+	secrets := DanglingImagePullSecret(reports, docs)
+	if secrets != 0 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 0)
+	}
+}
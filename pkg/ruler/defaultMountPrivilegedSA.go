@@ -0,0 +1,100 @@
+// OPR-R94-BUNDLE - workload mounts the token of a highly privileged service account by default
+package ruler
+
+import (
+	"encoding/json"
+
+	"github.com/controlplaneio/badrobot/pkg/rules"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// dangerousClusterRolePredicates match the ClusterRoles whose token is as good as
+// cluster-admin to an adversary
+var dangerousClusterRolePredicates = []func([]byte) int{
+	rules.StarAllClusterRole,
+	rules.StarAllCoreAPIClusterRole,
+	rules.StarClusterRoleAndBindings,
+	rules.SecretsClusterRole,
+	rules.ExecPodsClusterRole,
+	rules.EscalateClusterRole,
+	rules.BindClusterRole,
+	rules.ImpersonateClusterRole,
+}
+
+func DefaultMountPrivilegedSA(reports []Report, docs [][]byte) int {
+	dangerousRoles := map[string]bool{"cluster-admin": true}
+	noAutomount := make(map[string]bool)
+	privileged := make(map[string]bool)
+	bindings := make([]*rbacv1.ClusterRoleBinding, 0)
+	workloads := make([]*bundleObject, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		switch object.Kind {
+		case "ClusterRole":
+			for _, predicate := range dangerousClusterRolePredicates {
+				if predicate(doc) > 0 {
+					dangerousRoles[object.Metadata.Name] = true
+					break
+				}
+			}
+		case "ClusterRoleBinding":
+			binding := &rbacv1.ClusterRoleBinding{}
+			if json.Unmarshal(doc, binding) == nil {
+				bindings = append(bindings, binding)
+			}
+		case "ServiceAccount":
+			serviceAccount := &corev1.ServiceAccount{}
+			if json.Unmarshal(doc, serviceAccount) == nil && isDisabled(serviceAccount.AutomountServiceAccountToken) {
+				noAutomount[object.Metadata.Namespace+"/"+object.Metadata.Name] = true
+			}
+		default:
+			if object.podSpec() != nil {
+				workloads = append(workloads, object)
+			}
+		}
+	}
+
+	for _, binding := range bindings {
+		if binding.RoleRef.Kind != "ClusterRole" || !dangerousRoles[binding.RoleRef.Name] {
+			continue
+		}
+		for _, subject := range binding.Subjects {
+			if subject.Kind == rbacv1.ServiceAccountKind {
+				privileged[subject.Namespace+"/"+subject.Name] = true
+			}
+		}
+	}
+
+	mounted := 0
+	for _, workload := range workloads {
+		podSpec := workload.podSpec()
+
+		serviceAccount := podSpec.ServiceAccountName
+		if serviceAccount == "" {
+			serviceAccount = "default"
+		}
+		key := workload.Metadata.Namespace + "/" + serviceAccount
+
+		// the pod automountServiceAccountToken takes precedence over the service account
+		if !privileged[key] || podSpec.AutomountServiceAccountToken != nil || noAutomount[key] {
+			continue
+		}
+		mounted++
+	}
+
+	return mounted
+}
+
+func isDisabled(enabled *bool) bool {
+	return enabled != nil && !*enabled
+}
@@ -0,0 +1,120 @@
+package ruler
+
+import (
+	"testing"
+)
+
+const privilegedSABinding = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: manager-rolebinding
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: manager-role
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+const privilegedSARole = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: manager-role
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+  - list
+  - watch
+`
+
+const privilegedSADeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      serviceAccountName: controller-manager
+      containers:
+      - name: manager
+        image: controller:v1.0.0
+`
+
+func Test_DefaultMountPrivilegedSA_Chain(t *testing.T) {
+	reports, docs := bundleDocs(t, privilegedSARole, privilegedSABinding, privilegedSADeployment)
+
+	workloads := DefaultMountPrivilegedSA(reports, docs)
+	if workloads != 1 {
+		t.Errorf("Got %v workloads wanted %v", workloads, 1)
+	}
+}
+
+func Test_DefaultMountPrivilegedSA_PodDisabled(t *testing.T) {
+	reports, docs := bundleDocs(t, privilegedSARole, privilegedSABinding, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      serviceAccountName: controller-manager
+      automountServiceAccountToken: false
+      containers:
+      - name: manager
+        image: controller:v1.0.0
+`)
+
+	workloads := DefaultMountPrivilegedSA(reports, docs)
+	if workloads != 0 {
+		t.Errorf("Got %v workloads wanted %v", workloads, 0)
+	}
+}
+
+func Test_DefaultMountPrivilegedSA_ServiceAccountDisabled(t *testing.T) {
+	reports, docs := bundleDocs(t, privilegedSARole, privilegedSABinding, privilegedSADeployment, `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+automountServiceAccountToken: false
+`)
+
+	workloads := DefaultMountPrivilegedSA(reports, docs)
+	if workloads != 0 {
+		t.Errorf("Got %v workloads wanted %v", workloads, 0)
+	}
+}
+
+func Test_DefaultMountPrivilegedSA_UnprivilegedRole(t *testing.T) {
+	reports, docs := bundleDocs(t, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: manager-role
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - configmaps
+  verbs:
+  - get
+`, privilegedSABinding, privilegedSADeployment)
+
+	workloads := DefaultMountPrivilegedSA(reports, docs)
+	if workloads != 0 {
+		t.Errorf("Got %v workloads wanted %v", workloads, 0)
+	}
+}
@@ -0,0 +1,49 @@
+package ruler
+
+// DisableRules removes the rules and aggregate rules with the given IDs, so they are
+// neither evaluated nor reported. Unknown IDs are logged and ignored.
+func (rs *Ruleset) DisableRules(ids ...string) {
+	rs.selectRules(ids, false)
+}
+
+// EnableOnly removes every rule and aggregate rule except those with the given IDs.
+// Unknown IDs are logged and ignored.
+func (rs *Ruleset) EnableOnly(ids ...string) {
+	rs.selectRules(ids, true)
+}
+
+// selectRules keeps the rules whose ID is in ids when keep is true, or those whose ID
+// is not in ids when keep is false
+func (rs *Ruleset) selectRules(ids []string, keep bool) {
+	found := make(map[string]bool)
+
+	list := make([]Rule, 0, len(rs.Rules))
+	for _, rule := range rs.Rules {
+		listed := containsString(ids, rule.ID)
+		if listed {
+			found[rule.ID] = true
+		}
+		if listed == keep {
+			list = append(list, rule)
+		}
+	}
+	rs.Rules = list
+
+	aggregateList := make([]AggregateRule, 0, len(rs.AggregateRules))
+	for _, rule := range rs.AggregateRules {
+		listed := containsString(ids, rule.ID)
+		if listed {
+			found[rule.ID] = true
+		}
+		if listed == keep {
+			aggregateList = append(aggregateList, rule)
+		}
+	}
+	rs.AggregateRules = aggregateList
+
+	for _, id := range ids {
+		if !found[id] {
+			rs.logger.Debugf("no rule found with ID %v, ignoring", id)
+		}
+	}
+}
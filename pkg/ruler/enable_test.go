@@ -0,0 +1,75 @@
+package ruler
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+var privilegedPod = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+func hasRuleRef(ruleRefs []RuleRef, id string) bool {
+	for _, ruleRef := range ruleRefs {
+		if ruleRef.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRuleset_DisableRules(t *testing.T) {
+	json, err := yaml.YAMLToJSON([]byte(privilegedPod))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	before := rs.generateReport("operator.yaml", json, schemaDir)
+
+	rs.DisableRules("Privileged", "UnknownRule")
+	after := rs.generateReport("operator.yaml", json, schemaDir)
+
+	if hasRuleRef(after.Rules, "Privileged") || hasRuleRef(after.Scoring.Critical, "Privileged") {
+		t.Errorf("Got a Privileged rule ref wanted none")
+	}
+
+	if after.Score-before.Score != 16 {
+		t.Errorf("Got score %v wanted %v", after.Score, before.Score+16)
+	}
+}
+
+func TestRuleset_EnableOnly(t *testing.T) {
+	json, err := yaml.YAMLToJSON([]byte(privilegedPod))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	rs.EnableOnly("Privileged", "MissingRequiredConfigRef")
+
+	if len(rs.Rules) != 1 || len(rs.AggregateRules) != 1 {
+		t.Fatalf("Got %v rules and %v aggregate rules wanted %v and %v", len(rs.Rules), len(rs.AggregateRules), 1, 1)
+	}
+
+	report := rs.generateReport("operator.yaml", json, schemaDir)
+
+	if len(report.Rules) != 1 || report.Rules[0].ID != "Privileged" {
+		t.Errorf("Got rules %v wanted only %v", report.Rules, "Privileged")
+	}
+	if report.Score != -16 {
+		t.Errorf("Got score %v wanted %v", report.Score, -16)
+	}
+}
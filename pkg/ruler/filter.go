@@ -0,0 +1,66 @@
+package ruler
+
+// Scoring buckets a rule can be reported in
+const (
+	BucketCritical = "critical"
+	BucketPassed   = "passed"
+	BucketAdvise   = "advise"
+	BucketIgnored  = "ignored"
+)
+
+// FilterOptions selects the rules kept by FilterReports. Empty fields match everything.
+type FilterOptions struct {
+	Categories  []string
+	MinSeverity Severity
+	Buckets     []string
+}
+
+// FilterReports returns copies of the reports with the rules not matching the options removed.
+// Scores and messages are left as generated.
+func FilterReports(reports []Report, opts FilterOptions) []Report {
+	filtered := make([]Report, 0, len(reports))
+	for _, report := range reports {
+		report.Rules = opts.filter(report.Rules)
+		report.Scoring = RuleScoring{
+			Critical: opts.filterBucket(BucketCritical, report.Scoring.Critical),
+			Passed:   opts.filterBucket(BucketPassed, report.Scoring.Passed),
+			Advise:   opts.filterBucket(BucketAdvise, report.Scoring.Advise),
+			Ignored:  opts.filterBucket(BucketIgnored, report.Scoring.Ignored),
+		}
+		filtered = append(filtered, report)
+	}
+
+	return filtered
+}
+
+func (opts FilterOptions) filterBucket(bucket string, ruleRefs []RuleRef) []RuleRef {
+	if len(opts.Buckets) > 0 && !containsString(opts.Buckets, bucket) {
+		return make([]RuleRef, 0)
+	}
+
+	return opts.filter(ruleRefs)
+}
+
+func (opts FilterOptions) filter(ruleRefs []RuleRef) []RuleRef {
+	matched := make([]RuleRef, 0)
+	for _, ruleRef := range ruleRefs {
+		if len(opts.Categories) > 0 && !containsString(opts.Categories, ruleRef.Category) {
+			continue
+		}
+		if ruleRef.Severity.rank() < opts.MinSeverity.rank() {
+			continue
+		}
+		matched = append(matched, ruleRef)
+	}
+
+	return matched
+}
+
+func containsString(haystack []string, needle string) bool {
+	for _, item := range haystack {
+		if item == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,109 @@
+package ruler
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestFilterReports(t *testing.T) {
+	// no default rule reaches the Critical threshold
+	previous := CriticalPoints
+	CriticalPoints = -25
+	t.Cleanup(func() { CriticalPoints = previous })
+
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - "*"
+  resources:
+  - "*"
+  verbs:
+  - "*"
+- apiGroups:
+  - ""
+  resources:
+  - secrets
+  verbs:
+  - get
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	filtered := FilterReports(reports, FilterOptions{
+		Categories:  []string{CategoryRBAC},
+		MinSeverity: SeverityCritical,
+	})
+
+	if len(filtered) != len(reports) {
+		t.Fatalf("Got %v reports wanted %v", len(filtered), len(reports))
+	}
+
+	var matched []string
+	for _, report := range filtered {
+		for _, ruleRef := range append(append(report.Scoring.Critical, report.Scoring.Passed...), report.Scoring.Advise...) {
+			if ruleRef.Category != CategoryRBAC {
+				t.Errorf("Got category %v wanted %v for rule %v", ruleRef.Category, CategoryRBAC, ruleRef.ID)
+			}
+			if ruleRef.Severity != SeverityCritical {
+				t.Errorf("Got severity %v wanted %v for rule %v", ruleRef.Severity, SeverityCritical, ruleRef.ID)
+			}
+			matched = append(matched, ruleRef.ID)
+		}
+	}
+
+	if !containsString(matched, "StarAllClusterRole") {
+		t.Errorf("Got rules %v wanted %v", matched, "StarAllClusterRole")
+	}
+	if containsString(matched, "SecretsClusterRole") {
+		t.Errorf("Got rules %v wanted no %v", matched, "SecretsClusterRole")
+	}
+	if containsString(matched, "Privileged") {
+		t.Errorf("Got rules %v wanted no %v", matched, "Privileged")
+	}
+
+	// the original reports are left untouched
+	if len(reports[0].Scoring.Critical) == 0 {
+		t.Errorf("Got %v critical rules wanted many", len(reports[0].Scoring.Critical))
+	}
+}
+
+func TestFilterReports_Buckets(t *testing.T) {
+	reports := []Report{{
+		Scoring: RuleScoring{
+			Critical: []RuleRef{{ID: "Privileged", Points: -16, Severity: SeverityCritical}},
+			Passed:   []RuleRef{{ID: "SeccompProfile", Points: 3, Severity: SeverityInfo}},
+			Advise:   []RuleRef{{ID: "ResourceLimits", Points: 1, Severity: SeverityInfo}},
+		},
+	}}
+
+	filtered := FilterReports(reports, FilterOptions{Buckets: []string{BucketAdvise}})
+
+	if len(filtered[0].Scoring.Critical) != 0 || len(filtered[0].Scoring.Passed) != 0 {
+		t.Errorf("Got scoring %v wanted only advise", filtered[0].Scoring)
+	}
+	if len(filtered[0].Scoring.Advise) != 1 {
+		t.Errorf("Got %v advise rules wanted %v", len(filtered[0].Scoring.Advise), 1)
+	}
+}
@@ -0,0 +1,96 @@
+// OPR-R33-BUNDLE - required ConfigMap or Secret is not defined in the bundle
+package ruler
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func MissingRequiredConfigRef(reports []Report, docs [][]byte) int {
+	configMaps := make(map[string]bool)
+	secrets := make(map[string]bool)
+	podSpecs := make([]*corev1.PodSpec, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		switch object.Kind {
+		case "ConfigMap":
+			configMaps[object.Metadata.Name] = true
+		case "Secret":
+			secrets[object.Metadata.Name] = true
+		default:
+			if podSpec := object.podSpec(); podSpec != nil {
+				podSpecs = append(podSpecs, podSpec)
+			}
+		}
+	}
+
+	missing := make(map[string]bool)
+	requireConfigMap := func(name string, optional *bool) {
+		if !isOptional(optional) && !configMaps[name] {
+			missing["ConfigMap/"+name] = true
+		}
+	}
+	requireSecret := func(name string, optional *bool) {
+		if !isOptional(optional) && !secrets[name] {
+			missing["Secret/"+name] = true
+		}
+	}
+
+	for _, podSpec := range podSpecs {
+		for _, volume := range podSpec.Volumes {
+			if volume.ConfigMap != nil {
+				requireConfigMap(volume.ConfigMap.Name, volume.ConfigMap.Optional)
+			}
+			if volume.Secret != nil {
+				requireSecret(volume.Secret.SecretName, volume.Secret.Optional)
+			}
+			if volume.Projected != nil {
+				for _, source := range volume.Projected.Sources {
+					if source.ConfigMap != nil {
+						requireConfigMap(source.ConfigMap.Name, source.ConfigMap.Optional)
+					}
+					if source.Secret != nil {
+						requireSecret(source.Secret.Name, source.Secret.Optional)
+					}
+				}
+			}
+		}
+
+		containers := append(append([]corev1.Container{}, podSpec.InitContainers...), podSpec.Containers...)
+		for _, container := range containers {
+			for _, envFrom := range container.EnvFrom {
+				if envFrom.ConfigMapRef != nil {
+					requireConfigMap(envFrom.ConfigMapRef.Name, envFrom.ConfigMapRef.Optional)
+				}
+				if envFrom.SecretRef != nil {
+					requireSecret(envFrom.SecretRef.Name, envFrom.SecretRef.Optional)
+				}
+			}
+			for _, env := range container.Env {
+				if env.ValueFrom == nil {
+					continue
+				}
+				if env.ValueFrom.ConfigMapKeyRef != nil {
+					requireConfigMap(env.ValueFrom.ConfigMapKeyRef.Name, env.ValueFrom.ConfigMapKeyRef.Optional)
+				}
+				if env.ValueFrom.SecretKeyRef != nil {
+					requireSecret(env.ValueFrom.SecretKeyRef.Name, env.ValueFrom.SecretKeyRef.Optional)
+				}
+			}
+		}
+	}
+
+	return len(missing)
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
@@ -0,0 +1,87 @@
+package ruler
+
+import (
+	"testing"
+)
+
+func Test_MissingRequiredConfigRef_Dangling(t *testing.T) {
+	reports, docs := bundleDocs(t, `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        env:
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              name: manager-token
+              key: token
+              optional: false
+        - name: LOG_LEVEL
+          valueFrom:
+            configMapKeyRef:
+              name: manager-config
+              key: level
+      volumes:
+      - name: config
+        configMap:
+          name: manager-config
+      - name: certs
+        secret:
+          secretName: webhook-certs
+          optional: true
+`, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: manager-config
+data:
+  level: debug
+`)
+
+	refs := MissingRequiredConfigRef(reports, docs)
+	if refs != 1 {
+		t.Errorf("Got %v references wanted %v", refs, 1)
+	}
+}
+
+func Test_MissingRequiredConfigRef_Defined(t *testing.T) {
+	reports, docs := bundleDocs(t, `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: controller-manager
+spec:
+  containers:
+  - name: manager
+    envFrom:
+    - secretRef:
+        name: manager-token
+  volumes:
+  - name: config
+    projected:
+      sources:
+      - configMap:
+          name: manager-config
+`, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: manager-config
+`, `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: manager-token
+`)
+
+	refs := MissingRequiredConfigRef(reports, docs)
+	if refs != 0 {
+		t.Errorf("Got %v references wanted %v", refs, 0)
+	}
+}
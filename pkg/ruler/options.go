@@ -0,0 +1,46 @@
+package ruler
+
+import (
+	"github.com/controlplaneio/badrobot/pkg/rules"
+)
+
+// UsePrivateRegistryOnly enables UnqualifiedImageRegistry, for clusters that can only
+// pull from private registries
+func (rs *Ruleset) UsePrivateRegistryOnly() {
+	rs.usePredicates("UnqualifiedImageRegistry", rules.NewUnqualifiedImageRegistry(true))
+}
+
+// UseInternalRegistries sets the registry hosts or repository prefixes of the internal
+// build registry for InternalRegistryTagPolicy and InternalRegistryDigest
+func (rs *Ruleset) UseInternalRegistries(registries ...string) {
+	rs.usePredicates("InternalRegistryTagPolicy", rules.NewInternalRegistryTagPolicy(registries))
+	rs.usePredicates("InternalRegistryDigest", rules.NewInternalRegistryDigest(registries))
+}
+
+// UseMaxReplicas sets the largest replica count ExcessiveReplicas allows
+func (rs *Ruleset) UseMaxReplicas(maxReplicas int32) {
+	rs.usePredicates("ExcessiveReplicas", rules.NewExcessiveReplicas(maxReplicas))
+}
+
+// UseMaxContainers sets the largest number of containers TooManyContainers allows in a pod
+func (rs *Ruleset) UseMaxContainers(maxContainers int) {
+	rs.usePredicates("TooManyContainers", rules.NewTooManyContainers(maxContainers))
+}
+
+// UseSensitiveResources sets the core API resources on which
+// WildcardVerbsOnSensitiveResources flags the * verb
+func (rs *Ruleset) UseSensitiveResources(resources ...string) {
+	rs.usePredicates("WildcardVerbsOnSensitiveResources", rules.NewWildcardVerbsOnSensitiveResources(resources))
+}
+
+// usePredicates replaces the predicates of the rules with the given ID. Options are
+// bound into the predicates rather than read from package state, so rulesets with
+// different options can run at the same time.
+func (rs *Ruleset) usePredicates(id string, predicates rules.Predicates) {
+	for i := range rs.Rules {
+		if rs.Rules[i].ID == id {
+			rs.Rules[i].Predicate = predicates.Predicate
+			rs.Rules[i].ParsedPredicate = predicates.ParsedPredicate
+		}
+	}
+}
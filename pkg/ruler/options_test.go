@@ -0,0 +1,129 @@
+package ruler
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+// matchedRule reports whether the rule with the given ID matched the document
+func matchedRule(t *testing.T, rs *Ruleset, data string, id string) bool {
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := rs.generateReport("operator.yaml", json, schemaDir)
+	for _, ruleRef := range append(report.Scoring.Critical, report.Scoring.Passed...) {
+		if ruleRef.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func TestRuleset_UsePrivateRegistryOnly(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  containers:
+  - name: manager
+    image: nginx:1.25
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	if matchedRule(t, rs, data, "UnqualifiedImageRegistry") {
+		t.Errorf("Got UnqualifiedImageRegistry matched by default")
+	}
+
+	private := NewRuleset(zap.NewNop().Sugar())
+	private.UsePrivateRegistryOnly()
+	if !matchedRule(t, private, data, "UnqualifiedImageRegistry") {
+		t.Errorf("Got UnqualifiedImageRegistry not matched with UsePrivateRegistryOnly")
+	}
+	if matchedRule(t, rs, data, "UnqualifiedImageRegistry") {
+		t.Errorf("Got UnqualifiedImageRegistry matched by a ruleset without UsePrivateRegistryOnly")
+	}
+}
+
+func TestRuleset_UseMaxReplicas(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operator
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	if matchedRule(t, rs, data, "ExcessiveReplicas") {
+		t.Errorf("Got ExcessiveReplicas matched with the default limit")
+	}
+
+	rs.UseMaxReplicas(2)
+	if !matchedRule(t, rs, data, "ExcessiveReplicas") {
+		t.Errorf("Got ExcessiveReplicas not matched above a limit of 2")
+	}
+}
+
+func TestRuleset_UseMaxContainers(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  containers:
+  - name: manager
+  - name: proxy
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	if matchedRule(t, rs, data, "TooManyContainers") {
+		t.Errorf("Got TooManyContainers matched with the default limit")
+	}
+
+	rs.UseMaxContainers(1)
+	if !matchedRule(t, rs, data, "TooManyContainers") {
+		t.Errorf("Got TooManyContainers not matched above a limit of 1")
+	}
+}
+
+func TestRuleset_UseSensitiveResources(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - pods
+  verbs:
+  - "*"
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	if matchedRule(t, rs, data, "WildcardVerbsOnSensitiveResources") {
+		t.Errorf("Got WildcardVerbsOnSensitiveResources matched on pods by default")
+	}
+
+	rs.UseSensitiveResources("pods")
+	if !matchedRule(t, rs, data, "WildcardVerbsOnSensitiveResources") {
+		t.Errorf("Got WildcardVerbsOnSensitiveResources not matched on a configured resource")
+	}
+}
@@ -0,0 +1,44 @@
+package ruler
+
+import (
+	"io/ioutil"
+
+	"github.com/ghodss/yaml"
+)
+
+// LoadPointOverrides reads a YAML or JSON file mapping rule IDs to points, e.g.
+//
+//	Privileged: -4
+//	HostNetwork: -2
+func LoadPointOverrides(path string) (map[string]int, error) {
+	fileBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(map[string]int)
+	if err := yaml.Unmarshal(fileBytes, &overrides); err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// ApplyPointOverrides replaces the points of each rule whose ID is in the overrides map.
+// Overrides for unknown rule IDs are logged and ignored.
+func (rs *Ruleset) ApplyPointOverrides(overrides map[string]int) {
+	for id, points := range overrides {
+		var found bool
+		for i := range rs.Rules {
+			if rs.Rules[i].ID == id {
+				rs.logger.Debugf("overriding points for rule %v from %v to %v", id, rs.Rules[i].Points, points)
+				rs.Rules[i].Points = points
+				found = true
+			}
+		}
+
+		if !found {
+			rs.logger.Warnf("no rule found with ID %v, ignoring points override", id)
+		}
+	}
+}
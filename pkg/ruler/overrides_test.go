@@ -0,0 +1,80 @@
+package ruler
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+func TestLoadPointOverrides(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overrides.yaml")
+	err := ioutil.WriteFile(path, []byte("Privileged: -4\nUnknownRule: 10\n"), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	overrides, err := LoadPointOverrides(path)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(overrides) != 2 {
+		t.Errorf("Got %v overrides wanted %v", len(overrides), 2)
+	}
+	if overrides["Privileged"] != -4 {
+		t.Errorf("Got %v points wanted %v", overrides["Privileged"], -4)
+	}
+}
+
+func TestLoadPointOverrides_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overrides.yaml")
+	err := ioutil.WriteFile(path, []byte("Privileged: high\n"), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	_, err = LoadPointOverrides(path)
+	if err == nil {
+		t.Errorf("Loading overrides succeeded when it shouldn't")
+	}
+}
+
+func TestRuleset_ApplyPointOverrides(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	before := rs.generateReport("operator.yaml", json, schemaDir)
+
+	rs.ApplyPointOverrides(map[string]int{"Privileged": -4, "UnknownRule": 10})
+	after := rs.generateReport("operator.yaml", json, schemaDir)
+
+	if after.Score-before.Score != 12 {
+		t.Errorf("Got score %v wanted %v", after.Score, before.Score+12)
+	}
+
+	for _, ruleRef := range after.Scoring.Critical {
+		if ruleRef.ID == "Privileged" && ruleRef.Points != -4 {
+			t.Errorf("Got %v points wanted %v", ruleRef.Points, -4)
+		}
+	}
+}
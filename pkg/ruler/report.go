@@ -10,22 +10,97 @@ type Report struct {
 	Message  string      `json:"message,omitempty"`
 	Score    int         `json:"score"`
 	Scoring  RuleScoring `json:"scoring,omitempty"`
+	// Bundle is set on the synthetic report of the aggregate rules of a file
+	Bundle bool `json:"-"`
+}
+
+// Passed reports whether the score meets the threshold
+func (r Report) Passed(threshold int) bool {
+	return r.Score >= threshold
+}
+
+// Failed reports whether a scan of the reports fails: a report is invalid or scores
+// at or below the threshold, so at the default threshold of 0 every resource has to
+// earn points. A bundle report has no positive rules to earn points with, so it only
+// fails when a rule that is not advisory matched.
+func (reports Reports) Failed(threshold int) bool {
+	for _, r := range reports {
+		if !r.Valid {
+			return true
+		}
+		if r.Bundle && r.Score < 0 || !r.Bundle && r.Score <= threshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FileReport is the overall verdict for all documents of a single file
+type FileReport struct {
+	FileName    string   `json:"fileName"`
+	Score       int      `json:"score"`
+	Passed      bool     `json:"passed"`
+	WorstObject string   `json:"worstObject,omitempty"`
+	WorstScore  int      `json:"worstScore"`
+	Reports     []Report `json:"reports"`
+}
+
+// ScoreFile sums the scores of the reports of a file and records the lowest scoring
+// object. The file passes when every document is valid and the total meets the threshold.
+func (rs *Ruleset) ScoreFile(reports []Report) FileReport {
+	fileReport := FileReport{
+		Passed:  true,
+		Reports: reports,
+	}
+
+	var scored bool
+	for _, report := range reports {
+		if fileReport.FileName == "" {
+			fileReport.FileName = report.FileName
+		}
+
+		if !report.Valid {
+			fileReport.Passed = false
+			continue
+		}
+
+		fileReport.Score += report.Score
+		if !scored || report.Score < fileReport.WorstScore {
+			fileReport.WorstObject = report.Object
+			fileReport.WorstScore = report.Score
+			scored = true
+		}
+	}
+
+	if fileReport.Score < rs.Threshold {
+		fileReport.Passed = false
+	}
+
+	return fileReport
 }
 
 type RuleScoring struct {
 	Critical []RuleRef `json:"critical,omitempty"`
 	Passed   []RuleRef `json:"passed,omitempty"`
 	Advise   []RuleRef `json:"advise,omitempty"`
+	// Ignored holds the matched rules suppressed by the ignore annotation of the object
+	Ignored []RuleRef `json:"ignored,omitempty"`
 }
 
 type RuleRef struct {
-	ID         string `json:"id"`
-	Selector   string `json:"selector"`
-	Reason     string `json:"reason"`
-	Weight     int    `json:"weight,omitempty"`
-	Link       string `json:"href,omitempty"`
-	Containers int    `json:"-"`
-	Points     int    `json:"points"`
+	ID          string   `json:"id"`
+	Selector    string   `json:"selector"`
+	Reason      string   `json:"reason"`
+	Remediation string   `json:"remediation,omitempty"`
+	Weight      int      `json:"weight,omitempty"`
+	Link        string   `json:"href,omitempty"`
+	Category    string   `json:"category,omitempty"`
+	Severity    Severity `json:"severity,omitempty"`
+	Containers  int      `json:"-"`
+	Points      int      `json:"points"`
+	// Advisory is set on the matches of advisory aggregate rules, which do not change the score
+	Advisory bool `json:"advisory,omitempty"`
 }
 
 // This implements a custom sort interface (Len, Swap, Less) for the report listing.
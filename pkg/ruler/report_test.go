@@ -0,0 +1,275 @@
+package ruler
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
+)
+
+func TestReport_Passed(t *testing.T) {
+	tests := []struct {
+		score  int
+		passed bool
+	}{
+		{score: 5, passed: true},
+		{score: 4, passed: false},
+		{score: 20, passed: true},
+	}
+
+	for _, test := range tests {
+		report := Report{Score: test.score}
+		if passed := report.Passed(5); passed != test.passed {
+			t.Errorf("Got passed %v for score %v wanted %v", passed, test.score, test.passed)
+		}
+	}
+}
+
+func TestReports_Failed(t *testing.T) {
+	tests := []struct {
+		score     int
+		threshold int
+		bundle    bool
+		failed    bool
+	}{
+		{score: 1, threshold: 0, failed: false},
+		{score: 0, threshold: 0, failed: true},
+		{score: -3, threshold: 0, failed: true},
+		{score: 5, threshold: 5, failed: true},
+		{score: 6, threshold: 5, failed: false},
+		{score: -5, threshold: -10, failed: false},
+		{score: 0, threshold: 5, bundle: true, failed: false},
+		{score: 0, threshold: 0, bundle: true, failed: false},
+		{score: -30, threshold: 5, bundle: true, failed: true},
+		{score: -30, threshold: -40, bundle: true, failed: true},
+	}
+
+	for _, test := range tests {
+		reports := Reports{{Valid: true, Score: test.score, Bundle: test.bundle}}
+		if failed := reports.Failed(test.threshold); failed != test.failed {
+			t.Errorf("Got failed %v for score %v, bundle %v and threshold %v wanted %v", failed, test.score, test.bundle, test.threshold, test.failed)
+		}
+	}
+
+	if !(Reports{{Valid: false, Score: 10}}).Failed(0) {
+		t.Errorf("Got passed scan of an invalid report")
+	}
+}
+
+func TestReports_Failed_Threshold(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  automountServiceAccountToken: false
+  securityContext:
+    seccompProfile:
+      type: RuntimeDefault
+  containers:
+  - name: c1
+    image: registry.example.com/operator:1.0
+    envFrom:
+    - configMapRef:
+        name: operator-config
+    securityContext:
+      readOnlyRootFilesystem: true
+      runAsNonRoot: true
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data+data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	bundle := reports[len(reports)-1]
+	if !bundle.Bundle || len(bundle.Scoring.Critical) == 0 || !bundle.Scoring.Critical[0].Advisory {
+		t.Fatalf("Got last report %v wanted a bundle report with advisory findings", bundle.Object)
+	}
+
+	threshold := reports[0].Score
+	if threshold <= 0 {
+		t.Fatalf("Got score %v wanted a positive value", threshold)
+	}
+
+	if Reports(reports).Failed(threshold - 1) {
+		t.Errorf("Got failed reports at threshold %v wanted passed", threshold-1)
+	}
+	if !Reports(reports).Failed(threshold) {
+		t.Errorf("Got passed reports at threshold %v wanted failed", threshold)
+	}
+}
+
+func TestRuleset_Threshold(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  securityContext:
+    seccompProfile:
+      type: RuntimeDefault
+  containers:
+  - name: c1
+    securityContext:
+      readOnlyRootFilesystem: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	report := rs.generateReport("operator.yaml", json, schemaDir)
+	if report.Score <= 0 {
+		t.Fatalf("Got score %v wanted a positive value", report.Score)
+	}
+
+	rs.Threshold = report.Score
+	atThreshold := rs.generateReport("operator.yaml", json, schemaDir)
+	if !strings.HasPrefix(atThreshold.Message, "Passed") {
+		t.Errorf("Got message %q wanted a pass at the threshold", atThreshold.Message)
+	}
+
+	rs.Threshold = report.Score + 1
+	belowThreshold := rs.generateReport("operator.yaml", json, schemaDir)
+	if !strings.HasPrefix(belowThreshold.Message, "Failed") {
+		t.Errorf("Got message %q wanted a failure below the threshold", belowThreshold.Message)
+	}
+}
+
+func TestRuleset_WeightedScoring(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  securityContext:
+    seccompProfile:
+      type: RuntimeDefault
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	rs.EnableOnly("Privileged", "SeccompProfile")
+	for i := range rs.Rules {
+		if rs.Rules[i].ID == "Privileged" {
+			rs.Rules[i].Weight = 3
+		}
+	}
+
+	unweighted := rs.generateReport("operator.yaml", json, schemaDir)
+	if unweighted.Score != -16+3 {
+		t.Errorf("Got unweighted score %v wanted %v", unweighted.Score, -16+3)
+	}
+
+	rs.WeightedScoring = true
+	weighted := rs.generateReport("operator.yaml", json, schemaDir)
+	if weighted.Score != -16*3+3 {
+		t.Errorf("Got weighted score %v wanted %v", weighted.Score, -16*3+3)
+	}
+}
+
+func TestRuleset_ScoreFile(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      serviceAccountName: controller-manager
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - example.com
+  resources:
+  - widgets
+  verbs:
+  - get
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	reports, err := rs.Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var sum int
+	for _, report := range reports {
+		sum += report.Score
+	}
+
+	fileReport := rs.ScoreFile(reports)
+	if fileReport.FileName != "operator.yaml" {
+		t.Errorf("Got file name %v wanted %v", fileReport.FileName, "operator.yaml")
+	}
+	if fileReport.Score != sum {
+		t.Errorf("Got score %v wanted %v", fileReport.Score, sum)
+	}
+	if fileReport.WorstObject != "Deployment/controller-manager.operator-system" {
+		t.Errorf("Got worst object %v wanted %v", fileReport.WorstObject, "Deployment/controller-manager.operator-system")
+	}
+	if len(fileReport.Reports) != len(reports) {
+		t.Errorf("Got %v reports wanted %v", len(fileReport.Reports), len(reports))
+	}
+
+	rs.Threshold = fileReport.Score
+	if !rs.ScoreFile(reports).Passed {
+		t.Errorf("Got a failure at the threshold wanted a pass")
+	}
+
+	rs.Threshold = fileReport.Score + 1
+	if rs.ScoreFile(reports).Passed {
+		t.Errorf("Got a pass below the threshold wanted a failure")
+	}
+
+	reports[1].Valid = false
+	rs.Threshold = fileReport.Score - 100
+	if rs.ScoreFile(reports).Passed {
+		t.Errorf("Got a pass with an invalid document wanted a failure")
+	}
+}
@@ -15,16 +15,26 @@ func (e *NotSupportedError) Error() string {
 }
 
 type Rule struct {
-	Selector  string
-	ID        string
-	Title     string
-	Reason    string
-	Link      string
-	Kinds     []string
-	Points    int
-	Weight    int
-	Advise    int
-	Predicate func([]byte) int
+	Selector    string
+	ID          string
+	Title       string
+	Reason      string
+	Remediation string
+	Link        string
+	Category    string
+	Kinds       []string
+	Points      int
+	Weight      int
+	Advise      int
+	Predicate   func([]byte) int
+	// ParsedPredicate is optional, when set it is used instead of Predicate to evaluate
+	// a document that has already been parsed
+	ParsedPredicate func(map[string]interface{}) int
+	// DetailedPredicate is optional, when set it is used instead of Predicate and the
+	// detail it returns for a match is added to the reason in the report
+	DetailedPredicate func([]byte) (int, string)
+	// ScalePoints multiplies Points by the predicate result when the rule matches
+	ScalePoints bool
 }
 
 // Eval executes the predicate if the kind matches the rule
@@ -36,6 +46,34 @@ func (r *Rule) Eval(json []byte) (int, error) {
 
 	kind := fmt.Sprintf("%s", jq.Get())
 
+	containers, _, err := r.evalKind(kind, json, nil)
+	return containers, err
+}
+
+// EvalParsed executes the predicate if the kind matches the rule, reading the kind from
+// the parsed document instead of parsing json again
+func (r *Rule) EvalParsed(json []byte, doc map[string]interface{}) (int, error) {
+	containers, _, err := r.evalParsedDetailed(json, doc)
+	return containers, err
+}
+
+// evalParsedDetailed is EvalParsed that also returns the detail of a DetailedPredicate
+func (r *Rule) evalParsedDetailed(json []byte, doc map[string]interface{}) (int, string, error) {
+	var kind string
+	if doc == nil {
+		jq := gojsonq.New().Reader(bytes.NewReader(json)).From("kind")
+		if jq.Error() != nil {
+			return 0, "", jq.Error()
+		}
+		kind = fmt.Sprintf("%s", jq.Get())
+	} else {
+		kind = fmt.Sprintf("%s", doc["kind"])
+	}
+
+	return r.evalKind(kind, json, doc)
+}
+
+func (r *Rule) evalKind(kind string, json []byte, doc map[string]interface{}) (int, string, error) {
 	var match bool
 	for _, k := range r.Kinds {
 		if k == kind {
@@ -44,10 +82,18 @@ func (r *Rule) Eval(json []byte) (int, error) {
 		}
 	}
 
-	if match {
-		count := r.Predicate(json)
-		return count, nil
-	} else {
-		return 0, &NotSupportedError{Kind: kind}
+	if !match {
+		return 0, "", &NotSupportedError{Kind: kind}
+	}
+
+	if r.DetailedPredicate != nil {
+		containers, detail := r.DetailedPredicate(json)
+		return containers, detail, nil
+	}
+
+	if r.ParsedPredicate != nil && doc != nil {
+		return r.ParsedPredicate(doc), "", nil
 	}
+
+	return r.Predicate(json), "", nil
 }
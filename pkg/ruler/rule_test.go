@@ -6,6 +6,7 @@ import (
 
 	"github.com/controlplaneio/badrobot/pkg/rules"
 	"github.com/ghodss/yaml"
+	"go.uber.org/zap"
 )
 
 func TestRule_Eval(t *testing.T) {
@@ -121,3 +122,166 @@ spec:
 		t.Errorf("Rule succeeded when it shouldn't")
 	}
 }
+
+func TestRule_EvalParsed(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: default
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rule := &Rule{
+		Predicate: rules.DefaultNamespace,
+		ParsedPredicate: func(doc map[string]interface{}) int {
+			metadata, _ := doc["metadata"].(map[string]interface{})
+			if metadata["name"] == "default" {
+				return 1
+			}
+			return 0
+		},
+		Kinds: []string{"Namespace"},
+	}
+
+	parsed, err := rule.EvalParsed(json, parseDocument(json))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if parsed != 1 {
+		t.Errorf("Got count %d wanted %d", parsed, 1)
+	}
+
+	unparsed, err := rule.EvalParsed(json, nil)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if unparsed != parsed {
+		t.Errorf("Got count %d wanted %d", unparsed, parsed)
+	}
+}
+
+func TestRule_EvalParsedDoesNotApply(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rule := &Rule{
+		Predicate: rules.AllowPrivilegeEscalation,
+		Kinds:     []string{"Deployment"},
+	}
+
+	_, err = rule.EvalParsed(json, parseDocument(json))
+	if _, ok := err.(*NotSupportedError); !ok {
+		t.Errorf("Got error %v wanted %T", err, &NotSupportedError{})
+	}
+}
+
+var benchmarkDeployment = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+  labels:
+    control-plane: controller-manager
+spec:
+  selector:
+    matchLabels:
+      control-plane: controller-manager
+  replicas: 1
+  template:
+    metadata:
+      annotations:
+        kubectl.kubernetes.io/default-container: manager
+      labels:
+        control-plane: controller-manager
+    spec:
+      securityContext:
+        runAsNonRoot: true
+        seccompProfile:
+          type: RuntimeDefault
+      containers:
+      - name: kube-rbac-proxy
+        image: gcr.io/kubebuilder/kube-rbac-proxy:v0.13.0
+        args:
+        - --secure-listen-address=0.0.0.0:8443
+        - --upstream=http://127.0.0.1:8080/
+        ports:
+        - containerPort: 8443
+          name: https
+        securityContext:
+          allowPrivilegeEscalation: false
+          capabilities:
+            drop:
+            - ALL
+      - name: manager
+        image: controller:latest
+        command:
+        - /manager
+        args:
+        - --leader-elect
+        livenessProbe:
+          httpGet:
+            path: /healthz
+            port: 8081
+        readinessProbe:
+          httpGet:
+            path: /readyz
+            port: 8081
+        resources:
+          limits:
+            cpu: 500m
+            memory: 128Mi
+        securityContext:
+          allowPrivilegeEscalation: false
+          capabilities:
+            drop:
+            - ALL
+      serviceAccountName: controller-manager
+`
+
+func benchmarkRuleEval(b *testing.B, parsed bool) {
+	json, err := yaml.YAMLToJSON([]byte(benchmarkDeployment))
+	if err != nil {
+		b.Fatal(err.Error())
+	}
+
+	ruleset := NewRuleset(zap.NewNop().Sugar())
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if parsed {
+			doc := parseDocument(json)
+			for _, rule := range ruleset.Rules {
+				_, _ = rule.EvalParsed(json, doc)
+			}
+		} else {
+			for _, rule := range ruleset.Rules {
+				_, _ = rule.Eval(json)
+			}
+		}
+	}
+}
+
+func BenchmarkRule_Eval(b *testing.B) {
+	benchmarkRuleEval(b, false)
+}
+
+func BenchmarkRule_EvalParsed(b *testing.B) {
+	benchmarkRuleEval(b, true)
+}
@@ -2,11 +2,15 @@ package ruler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"reflect"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 
 	"github.com/controlplaneio/badrobot/pkg/rules"
@@ -17,8 +21,15 @@ import (
 )
 
 type Ruleset struct {
-	Rules  []Rule
-	logger *zap.SugaredLogger
+	Rules          []Rule
+	AggregateRules []AggregateRule
+	// Concurrency is the maximum number of rules evaluated in parallel per document
+	Concurrency int
+	// Threshold is the minimum score for a report to pass
+	Threshold int
+	// WeightedScoring multiplies the points of each matched rule by its weight
+	WeightedScoring bool
+	logger          *zap.SugaredLogger
 }
 
 type InvalidInputError struct {
@@ -28,307 +39,1459 @@ func (e *InvalidInputError) Error() string {
 	return "Invalid input"
 }
 
-func NewRuleset(logger *zap.SugaredLogger) *Ruleset {
+// NewRuleset returns the default rules, followed by any extra rules such as those
+// loaded with LoadRulesFromFile
+func NewRuleset(logger *zap.SugaredLogger, extra ...Rule) *Ruleset {
 	list := make([]Rule, 0)
 
 	// OPR-R1-NS - default namespace
 	defaultNamespaceRule := Rule{
-		Predicate: rules.DefaultNamespace,
-		ID:        "DefaultNamespace",
-		Selector:  ".metadata .name == default .subjects .namespace == default",
-		Reason:    "Operator is deployed into the default namespace.",
-		Kinds:     []string{"Namespace", "Deployment", "ClusterRoleBinding"},
-		Points:    -1,
+		Predicate:   predicate("DefaultNamespace"),
+		ID:          "DefaultNamespace",
+		Selector:    ".metadata .name == default .subjects .namespace == default",
+		Reason:      "Operator is deployed into the default namespace.",
+		Remediation: "Deploy the Operator into a dedicated namespace",
+		Link:        "https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+		Kinds:       []string{"Namespace", "Deployment", "ClusterRoleBinding"},
+		Category:    CategoryNamespace,
+		Points:      -1,
 	}
 	list = append(list, defaultNamespaceRule)
 
 	// OPR-R2-NS - kube-system namespace
 	kubesystemNamespaceRule := Rule{
-		Predicate: rules.KubeSystemNamespace,
-		ID:        "KubeSystemNamespace",
-		Selector:  ".metadata .name == kube-system .subjects .namespace == kube-system",
-		Reason:    "Operator is deployed into the kube-system namespace.",
-		Kinds:     []string{"Namespace", "Deployment", "ClusterRoleBinding"},
-		Points:    -9,
+		Predicate:   predicate("KubeSystemNamespace"),
+		ID:          "KubeSystemNamespace",
+		Selector:    ".metadata .name == kube-system .subjects .namespace == kube-system",
+		Reason:      "Operator is deployed into the kube-system namespace.",
+		Remediation: "Deploy the Operator into a dedicated namespace",
+		Link:        "https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/",
+		Kinds:       []string{"Namespace", "Deployment", "ClusterRoleBinding"},
+		Category:    CategoryNamespace,
+		Points:      -9,
 	}
 	list = append(list, kubesystemNamespaceRule)
 
 	// OPR-R3-SC - No securityContext
 	noSecurityContextRule := Rule{
-		Predicate: rules.NoSecurityContext,
-		ID:        "NoSecurityContext",
-		Selector:  ".spec .template .spec .securityContext .containers[] ",
-		Reason:    "Operators should be deployed with securityContextApplied",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -12,
+		Predicate:   predicate("NoSecurityContext"),
+		ID:          "NoSecurityContext",
+		Selector:    ".spec .template .spec .securityContext .containers[] ",
+		Reason:      "Operators should be deployed with securityContextApplied",
+		Remediation: "Set a securityContext on the pod and on every container",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      -12,
 	}
 	list = append(list, noSecurityContextRule)
 
 	// OPR-R4-SC - securityContext set to allowPrivilegeEscalation: true
 	allowPrivilegeEscalation := Rule{
-		Predicate: rules.AllowPrivilegeEscalation,
-		ID:        "AllowPrivilegeEscalation",
-		Selector:  ".spec .containers[] .securityContext .allowPrivilegeEscalation == true",
-		Reason:    "Operators should not deploy with allowPrivilegeEscalation: true",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -12,
+		Predicate:   predicate("AllowPrivilegeEscalation"),
+		ID:          "AllowPrivilegeEscalation",
+		Selector:    ".spec .containers[] .securityContext .allowPrivilegeEscalation == true",
+		Reason:      "Operators should not deploy with allowPrivilegeEscalation: true",
+		Remediation: "Set securityContext.allowPrivilegeEscalation: false on every container",
+		Link:        "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      -12,
 	}
 	list = append(list, allowPrivilegeEscalation)
 
 	// OPR-R5-SC - securityContext set to privileged: true
 	privilegedRule := Rule{
-		Predicate: rules.Privileged,
-		ID:        "Privileged",
-		Selector:  ".spec .containers[] .securityContext .privileged == true",
-		Reason:    "Operators should not deploy with privileged: true",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -16,
+		Predicate:         predicate("Privileged"),
+		DetailedPredicate: privilegedDetailed,
+		ID:                "Privileged",
+		Selector:          ".spec .containers[] .initContainers[] .securityContext .privileged == true",
+		Reason:            "Operators should not deploy with privileged: true",
+		Remediation:       "Remove securityContext.privileged: true, or set it to false, on every container",
+		Link:              "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:             []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:          CategoryPodSecurity,
+		Points:            -16,
 	}
 	list = append(list, privilegedRule)
 
 	// OPR-R6-SC - securityContext set to readOnlyRootFilesystem: false
 	readOnlyRootFilesystemRule := Rule{
-		Predicate: rules.ReadOnlyRootFilesystem,
-		ID:        "ReadOnlyRootFilesystem",
-		Selector:  ".spec .containers[] .securityContext .readOnlyRootFilesystem == false",
-		Reason:    "Operators should not deploy with readOnlyRootFilesystem: true",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -6,
+		Predicate:       predicate("ReadOnlyRootFilesystem"),
+		ParsedPredicate: parsedPredicate("ReadOnlyRootFilesystem"),
+		ID:              "ReadOnlyRootFilesystem",
+		Selector:        ".spec .containers[] .initContainers[] .securityContext .readOnlyRootFilesystem != true",
+		Reason:          "Every Operator container should deploy with readOnlyRootFilesystem: true",
+		Remediation:     "Set securityContext.readOnlyRootFilesystem: true on every container and mount an emptyDir for writable paths",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -6,
 	}
 	list = append(list, readOnlyRootFilesystemRule)
 
 	// OPR-R7-SC - securityContext set to runAsNonRoot: false
 	runAsNonRootRule := Rule{
-		Predicate: rules.RunAsNonRoot,
-		ID:        "RunAsNonRoot",
-		Selector:  ".spec .containers[] .securityContext .runAsNonRoot == false",
-		Reason:    "Operators should not run as the root user",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -9,
+		Predicate:   predicate("RunAsNonRoot"),
+		ID:          "RunAsNonRoot",
+		Selector:    ".spec .containers[] .securityContext .runAsNonRoot == false",
+		Reason:      "Operators should not run as the root user",
+		Remediation: "Set securityContext.runAsNonRoot: true",
+		Link:        "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      -9,
 	}
 	list = append(list, runAsNonRootRule)
 
 	// OPR-R8-SC - securityContext set to runAsUser: 0
 	runAsUserRule := Rule{
-		Predicate: rules.RunAsUser,
-		ID:        "RunAsUser",
-		Selector:  ".spec containers[] .securityContext .runAsUser -gt 0",
-		Reason:    "Operators should not run as the root user (UID = 0)",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -9,
+		Predicate:       predicate("RunAsUser"),
+		ParsedPredicate: parsedPredicate("RunAsUser"),
+		ID:              "RunAsUser",
+		Selector:        ".spec containers[] .securityContext .runAsUser -gt 0",
+		Reason:          "Operators should not run as the root user (UID = 0)",
+		Remediation:     "Set securityContext.runAsUser to a UID above 10000",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -9,
 	}
 	list = append(list, runAsUserRule)
 
 	// OPR-R9-SC - securityContext adds CAP_SYS_ADMIN Linux capability
 	capSysAdminRule := Rule{
-		Predicate: rules.CapSysAdmin,
-		ID:        "CapSysAdmin",
-		Selector:  "containers[] .securityContext .capabilities .add == SYS_ADMIN",
-		Reason:    "CAP_SYS_ADMIN is the most privileged capability and where possible disabled for Operators",
-		Kinds:     []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
-		Points:    -16,
+		Predicate:   predicate("CapSysAdmin"),
+		ID:          "CapSysAdmin",
+		Selector:    "containers[] .securityContext .capabilities .add == SYS_ADMIN",
+		Reason:      "CAP_SYS_ADMIN is the most privileged capability and where possible disabled for Operators",
+		Remediation: "Remove SYS_ADMIN from securityContext.capabilities.add",
+		Link:        "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      -16,
 	}
 	list = append(list, capSysAdminRule)
 
 	// OPR-R10-RBAC - Runs as Cluster Admin
 	clusterAdminRule := Rule{
-		Predicate: rules.ClusterAdmin,
-		ID:        "ClusterAdmin",
-		Selector:  ".roleRef .name",
-		Reason:    "The Operator is using Kubernetes native cluster admin role. Operators must use a dedicated cluster role",
-		Kinds:     []string{"ClusterRoleBinding"},
-		Points:    -25,
+		Predicate:   predicate("ClusterAdmin"),
+		ID:          "ClusterAdmin",
+		Selector:    ".roleRef .name",
+		Reason:      "The Operator is using Kubernetes native cluster admin role. Operators must use a dedicated cluster role",
+		Remediation: "Bind the Operator to a dedicated ClusterRole with only the permissions it needs instead of cluster-admin",
+		Link:        "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:       []string{"ClusterRoleBinding"},
+		Category:    CategoryRBAC,
+		Points:      -25,
 	}
 	list = append(list, clusterAdminRule)
 
 	// OPR-R11-RBAC - ClusterRole has full permissions over all resources
 	starAllClusterRoleRule := Rule{
-		Predicate: rules.StarAllClusterRole,
-		ID:        "StarAllClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has full permissions on all resources in the cluster",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -25,
+		Predicate:       predicate("StarAllClusterRole"),
+		ParsedPredicate: parsedPredicate("StarAllClusterRole"),
+		ID:              "StarAllClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has full permissions on all resources in the cluster",
+		Remediation:     "Replace the * apiGroups, resources and verbs with the resources and verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -25,
 	}
 	list = append(list, starAllClusterRoleRule)
 
 	// OPR-R12-RBAC - ClusterRole has full permissions over all CoreAPI resources
 	starAllCoreAPIClusterRoleRule := Rule{
-		Predicate: rules.StarAllCoreAPIClusterRole,
-		ID:        "StarAllCoreAPIClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has full permissions on all CoreAPI resources in the cluster",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -16,
+		Predicate:       predicate("StarAllCoreAPIClusterRole"),
+		ParsedPredicate: parsedPredicate("StarAllCoreAPIClusterRole"),
+		ID:              "StarAllCoreAPIClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has full permissions on all CoreAPI resources in the cluster",
+		Remediation:     "Replace the * resources and verbs on the core API group with the resources and verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -16,
 	}
 	list = append(list, starAllCoreAPIClusterRoleRule)
 
 	// OPR-R13-RBAC - ClusterRole has full permissions over ClusterRoles and ClusterRoleBindings
 	starClusterRoleAndBindingsRule := Rule{
-		Predicate: rules.StarClusterRoleAndBindings,
-		ID:        "StarClusterRoleAndBindings",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has full permissions over ClusterRoles and ClusterRoleBindings",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -12,
+		Predicate:       predicate("StarClusterRoleAndBindings"),
+		ParsedPredicate: parsedPredicate("StarClusterRoleAndBindings"),
+		ID:              "StarClusterRoleAndBindings",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has full permissions over ClusterRoles and ClusterRoleBindings",
+		Remediation:     "Remove the * verbs on clusterroles and clusterrolebindings, or limit them to named roles with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -12,
 	}
 	list = append(list, starClusterRoleAndBindingsRule)
 
 	// OPR-R14-RBAC - ClusterRole has access to Kubernetes secrets
 	secretsClusterRoleRule := Rule{
-		Predicate: rules.SecretsClusterRole,
-		ID:        "SecretsClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has access to all secrets",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -12,
+		Predicate:       predicate("SecretsClusterRole"),
+		ParsedPredicate: parsedPredicate("SecretsClusterRole"),
+		ID:              "SecretsClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has access to all secrets",
+		Remediation:     "Remove access to secrets, or use a namespaced Role limited to the secrets the Operator needs with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -12,
 	}
 	list = append(list, secretsClusterRoleRule)
 
 	// OPR-R15-RBAC - ClusterRole can exec into Pods
 	execPodsClusterRoleRule := Rule{
-		Predicate: rules.ExecPodsClusterRole,
-		ID:        "ExecPodsClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions to exec into any pod in the cluster",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -9,
+		Predicate:       predicate("ExecPodsClusterRole"),
+		ParsedPredicate: parsedPredicate("ExecPodsClusterRole"),
+		ID:              "ExecPodsClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions to exec into any pod in the cluster",
+		Remediation:     "Remove the create verb on pods/exec",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
 	}
 	list = append(list, execPodsClusterRoleRule)
 
 	// OPR-R16-RBAC - ClusterRole has escalate permissions
 	escalateClusterRoleRule := Rule{
-		Predicate: rules.EscalateClusterRole,
-		ID:        "EscalateClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has escalate permissions",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -16,
+		Predicate:       predicate("EscalateClusterRole"),
+		ParsedPredicate: parsedPredicate("EscalateClusterRole"),
+		ID:              "EscalateClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has escalate permissions",
+		Remediation:     "Remove the escalate verb on roles and clusterroles",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -16,
 	}
 	list = append(list, escalateClusterRoleRule)
 
 	// OPR-R17-RBAC - ClusterRole has bind permissions
 	bindClusterRoleRule := Rule{
-		Predicate: rules.BindClusterRole,
-		ID:        "BindClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has bind permissions",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -16,
+		Predicate:       predicate("BindClusterRole"),
+		ParsedPredicate: parsedPredicate("BindClusterRole"),
+		ID:              "BindClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has bind permissions",
+		Remediation:     "Remove the bind verb, or limit it to the roles the Operator binds with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -16,
 	}
 	list = append(list, bindClusterRoleRule)
 
 	// OPR-R18-RBAC - ClusterRole has impersonate permissions
 	impersonateClusterRoleRule := Rule{
-		Predicate: rules.ImpersonateClusterRole,
-		ID:        "ImpersonateClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has impersonate permissions",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -20,
+		Predicate:       predicate("ImpersonateClusterRole"),
+		ParsedPredicate: parsedPredicate("ImpersonateClusterRole"),
+		ID:              "ImpersonateClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has impersonate permissions",
+		Remediation:     "Remove the impersonate verb",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -20,
 	}
 	list = append(list, impersonateClusterRoleRule)
 
 	// OPR-R19-RBAC - ClusterRole can modify pod logs
 	modifyPodLogsClusterRoleRule := Rule{
-		Predicate: rules.ModifyPodLogsClusterRole,
-		ID:        "ModifyPodLogsClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions to modify pod logs",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -2,
+		Predicate:       predicate("ModifyPodLogsClusterRole"),
+		ParsedPredicate: parsedPredicate("ModifyPodLogsClusterRole"),
+		ID:              "ModifyPodLogsClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions to modify pod logs",
+		Remediation:     "Remove the write verbs on pods/log",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -2,
 	}
 	list = append(list, modifyPodLogsClusterRoleRule)
 
 	// OPR-R20-RBAC - ClusterRole can remove Kubernetes events
 	removeEventsClusterRoleRule := Rule{
-		Predicate: rules.RemoveEventsClusterRole,
-		ID:        "RemoveEventsClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions to delete Kubernetes Events",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -2,
+		Predicate:       predicate("RemoveEventsClusterRole"),
+		ParsedPredicate: parsedPredicate("RemoveEventsClusterRole"),
+		ID:              "RemoveEventsClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions to delete Kubernetes Events",
+		Remediation:     "Remove the delete and deletecollection verbs on events",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -2,
 	}
 	list = append(list, removeEventsClusterRoleRule)
 
 	// OPR-R21-RBAC - ClusterRole has full permissions over any custom resource definitions
 	customResourceClusterRoleRule := Rule{
-		Predicate: rules.CustomResourceClusterRole,
-		ID:        "CustomResourceClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions over any Custom Resource",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -8,
+		Predicate:       predicate("CustomResourceClusterRole"),
+		ParsedPredicate: parsedPredicate("CustomResourceClusterRole"),
+		ID:              "CustomResourceClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions over any Custom Resource",
+		Remediation:     "Replace the * apiGroups with the API groups of the custom resources the Operator manages",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -8,
 	}
 	list = append(list, customResourceClusterRoleRule)
 
 	// OPR-R22-RBAC - ClusterRole has full permissions over admission controllers
 	admissionControllerClusterRoleRule := Rule{
-		Predicate: rules.AdmissionControllerClusterRole,
-		ID:        "AdmissionControllerClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has full permissions over Admission Controllers",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -12,
+		Predicate:       predicate("AdmissionControllerClusterRole"),
+		ParsedPredicate: parsedPredicate("AdmissionControllerClusterRole"),
+		ID:              "AdmissionControllerClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has full permissions over Admission Controllers",
+		Remediation:     "Remove the write verbs on admission webhook configurations, or limit them to the Operator webhooks with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -12,
 	}
 	list = append(list, admissionControllerClusterRoleRule)
 
 	// OPR-R23-RBAC - ClusterRole has permissions over service account token creation
 	serviceAccountClusterRoleRule := Rule{
-		Predicate: rules.ServiceAccountClusterRole,
-		ID:        "ServiceAccountClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions over service accounts to create token requests for existing service accounts",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -12,
+		Predicate:       predicate("ServiceAccountClusterRole"),
+		ParsedPredicate: parsedPredicate("ServiceAccountClusterRole"),
+		ID:              "ServiceAccountClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions over service accounts to create token requests for existing service accounts",
+		Remediation:     "Remove the create verb on serviceaccounts/token",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -12,
 	}
 	list = append(list, serviceAccountClusterRoleRule)
 
 	// OPR-R24-RBAC - ClusterRole has read, write or delete permissions over persistent volumes
 	persistentVolumeClusterRoleRule := Rule{
-		Predicate: rules.PersistentVolumeClusterRole,
-		ID:        "PersistentVolumeClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has read, write or delete permissions over persistent volumes",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -9,
+		Predicate:       predicate("PersistentVolumeClusterRole"),
+		ParsedPredicate: parsedPredicate("PersistentVolumeClusterRole"),
+		ID:              "PersistentVolumeClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has read, write or delete permissions over persistent volumes",
+		Remediation:     "Remove access to persistentvolumes, or limit it to the verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
 	}
 	list = append(list, persistentVolumeClusterRoleRule)
 
 	// OPR-R25-RBAC - ClusterRole has read, write or delete permissions over network policies
 	networkPolicyClusterRoleRule := Rule{
-		Predicate: rules.NetworkPolicyClusterRole,
-		ID:        "NetworkPolicyClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has modify permissions over network policies",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -9,
+		Predicate:       predicate("NetworkPolicyClusterRole"),
+		ParsedPredicate: parsedPredicate("NetworkPolicyClusterRole"),
+		ID:              "NetworkPolicyClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has modify permissions over network policies",
+		Remediation:     "Remove the write verbs on networkpolicies, or use a namespaced Role",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
 	}
 	list = append(list, networkPolicyClusterRoleRule)
 
 	// OPR-R26-RBAC - ClusterRole has permissions over the Kubernetes API server proxy
 	nodeProxyClusterRoleRule := Rule{
-		Predicate: rules.NodeProxyClusterRole,
-		ID:        "NodeProxyClusterRole",
-		Selector:  ".rules .apiGroups .resources .verbs",
-		Reason:    "The Operator SA cluster role has permissions the Kubernetes API server proxy",
-		Kinds:     []string{"ClusterRole"},
-		Points:    -16,
+		Predicate:       predicate("NodeProxyClusterRole"),
+		ParsedPredicate: parsedPredicate("NodeProxyClusterRole"),
+		ID:              "NodeProxyClusterRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA cluster role has permissions the Kubernetes API server proxy",
+		Remediation:     "Remove access to nodes/proxy",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -16,
 	}
 	list = append(list, nodeProxyClusterRoleRule)
 
+	// OPR-R27-SC - resources.limits set for cpu and memory
+	resourceLimitsRule := Rule{
+		Predicate:   predicate("ResourceLimits"),
+		ID:          "ResourceLimits",
+		Selector:    "containers[] .resources .limits .cpu .memory",
+		Reason:      "Enforcing CPU and memory limits prevents a compromised Operator from exhausting node resources",
+		Remediation: "Set resources.limits.cpu and resources.limits.memory on every container",
+		Link:        "https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      1,
+		Advise:      1,
+	}
+	list = append(list, resourceLimitsRule)
+
+	// OPR-R28-SC - hostPath volume defined but not mounted
+	unmountedHostPathVolumeRule := Rule{
+		Predicate:       predicate("UnmountedHostPathVolume"),
+		ParsedPredicate: parsedPredicate("UnmountedHostPathVolume"),
+		ID:              "UnmountedHostPathVolume",
+		Selector:        ".spec .volumes[] .hostPath",
+		Reason:          "A hostPath volume is defined but not mounted by any container",
+		Remediation:     "Remove the unused hostPath volume",
+		Link:            "https://kubernetes.io/docs/concepts/storage/volumes/#hostpath",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -1,
+	}
+	list = append(list, unmountedHostPathVolumeRule)
+
+	// OPR-R29-SC - livenessProbe defined
+	livenessProbeRule := Rule{
+		Predicate:       predicate("LivenessProbe"),
+		ParsedPredicate: parsedPredicate("LivenessProbe"),
+		ID:              "LivenessProbe",
+		Selector:        "containers[] .livenessProbe",
+		Reason:          "Liveness probes allow a hung or compromised Operator process to be detected and restarted",
+		Remediation:     "Add a livenessProbe to every container",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, livenessProbeRule)
+
+	// OPR-R30-SC - readinessProbe defined
+	readinessProbeRule := Rule{
+		Predicate:       predicate("ReadinessProbe"),
+		ParsedPredicate: parsedPredicate("ReadinessProbe"),
+		ID:              "ReadinessProbe",
+		Selector:        "containers[] .readinessProbe",
+		Reason:          "Readiness probes stop traffic being routed to an Operator that is not healthy",
+		Remediation:     "Add a readinessProbe to every container",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, readinessProbeRule)
+
+	// OPR-R31-RBAC - ClusterRole has access to all non-resource URLs
+	wildcardNonResourceURLsRule := Rule{
+		Predicate:       predicate("WildcardNonResourceURLs"),
+		ParsedPredicate: parsedPredicate("WildcardNonResourceURLs"),
+		ID:              "WildcardNonResourceURLs",
+		Selector:        ".rules .nonResourceURLs",
+		Reason:          "The Operator SA cluster role has access to all non-resource API endpoints",
+		Remediation:     "Replace the * nonResourceURLs with the endpoints the Operator uses, such as /metrics",
+		Link:            "https://kubernetes.io/docs/reference/access-authn-authz/rbac/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -3,
+	}
+	list = append(list, wildcardNonResourceURLsRule)
+
+	// OPR-R32-SC - securityContext sets a RuntimeDefault or Localhost seccompProfile
+	seccompProfileRule := Rule{
+		Predicate:       predicate("SeccompProfile"),
+		ParsedPredicate: parsedPredicate("SeccompProfile"),
+		ID:              "SeccompProfile",
+		Selector:        ".securityContext .seccompProfile .type == RuntimeDefault || Localhost",
+		Reason:          "A seccomp profile reduces the syscall attack surface available to a compromised Operator",
+		Remediation:     "Set securityContext.seccompProfile.type: RuntimeDefault",
+		Link:            "https://kubernetes.io/docs/tutorials/security/seccomp/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          3,
+		Advise:          1,
+	}
+	list = append(list, seccompProfileRule)
+
+	// OPR-R34-SC - securityContext adds ALL Linux capabilities
+	addAllCapabilitiesRule := Rule{
+		Predicate:       predicate("AddAllCapabilities"),
+		ParsedPredicate: parsedPredicate("AddAllCapabilities"),
+		ID:              "AddAllCapabilities",
+		Selector:        "containers[] .securityContext .capabilities .add == ALL",
+		Reason:          "Adding ALL capabilities is equivalent to privileged: true for Linux capabilities",
+		Remediation:     "Remove ALL from securityContext.capabilities.add and add only the capabilities the Operator needs",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -16,
+	}
+	list = append(list, addAllCapabilitiesRule)
+
+	// OPR-R37-SC - lifecycle hook executes a shell
+	shellLifecycleHookRule := Rule{
+		Predicate:       predicate("ShellLifecycleHook"),
+		ParsedPredicate: parsedPredicate("ShellLifecycleHook"),
+		ID:              "ShellLifecycleHook",
+		Selector:        "containers[] .lifecycle .postStart .preStop .exec .command[0] == sh",
+		Reason:          "Lifecycle hooks running a shell are a hidden code path outside the Operator entrypoint",
+		Remediation:     "Run the lifecycle hook command directly instead of through a shell, or move the logic into the Operator",
+		Link:            "https://kubernetes.io/docs/concepts/containers/container-lifecycle-hooks/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -2,
+	}
+	list = append(list, shellLifecycleHookRule)
+
+	// OPR-R38-RBAC - ServiceAccount annotated as critical automounts its token
+	criticalServiceAccountRule := Rule{
+		Predicate:   predicate("CriticalServiceAccount"),
+		ID:          "CriticalServiceAccount",
+		Selector:    ".metadata .annotations .badrobot.controlplane.io/critical .automountServiceAccountToken",
+		Reason:      "A ServiceAccount marked as critical does not disable automountServiceAccountToken",
+		Remediation: "Set automountServiceAccountToken: false on the ServiceAccount",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+		Kinds:       []string{"ServiceAccount"},
+		Category:    CategoryRBAC,
+		Points:      -3,
+	}
+	list = append(list, criticalServiceAccountRule)
+
+	// OPR-R39-RBAC - ClusterRole can update finalizers
+	finalizerWriteClusterRoleRule := Rule{
+		Predicate:       predicate("FinalizerWriteClusterRole"),
+		ParsedPredicate: parsedPredicate("FinalizerWriteClusterRole"),
+		ID:              "FinalizerWriteClusterRole",
+		Selector:        ".rules .resources */finalizers .verbs update",
+		Reason:          "The Operator SA cluster role can update finalizers, blocking or forcing the deletion of objects",
+		Remediation:     "Limit the update verb on finalizers to the resources the Operator owns",
+		Link:            "https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -3,
+	}
+	list = append(list, finalizerWriteClusterRoleRule)
+
+	// OPR-R40-SC - internal registry image uses a mutable tag
+	internalRegistryTagPolicyRule := Rule{
+		Predicate:       predicate("InternalRegistryTagPolicy"),
+		ParsedPredicate: parsedPredicate("InternalRegistryTagPolicy"),
+		ID:              "InternalRegistryTagPolicy",
+		Selector:        "containers[] .image =~ internal registry && !@sha256",
+		Reason:          "Images from the internal build registry should be pinned by digest rather than a mutable tag",
+		Remediation:     "Pin images from the internal registry by digest with @sha256:",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -2,
+	}
+	list = append(list, internalRegistryTagPolicyRule)
+
+	// OPR-R41-WH - webhook fails open
+	webhookFailOpenRule := Rule{
+		Predicate:   predicate("WebhookFailOpen"),
+		ID:          "WebhookFailOpen",
+		Selector:    ".webhooks[] .failurePolicy == Ignore",
+		Reason:      "The Operator webhook ignores failures, so objects are admitted unchecked whenever the webhook is unavailable",
+		Remediation: "Set failurePolicy: Fail on the webhook",
+		Link:        "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/",
+		Kinds:       []string{"ValidatingWebhookConfiguration", "MutatingWebhookConfiguration"},
+		Category:    CategoryAdmission,
+		Points:      -1,
+	}
+	list = append(list, webhookFailOpenRule)
+
+	// OPR-R42-WH - webhook intercepts all resources
+	broadWebhookRulesRule := Rule{
+		Predicate:   predicate("BroadWebhookRules"),
+		ID:          "BroadWebhookRules",
+		Selector:    ".webhooks[] .rules[] .apiGroups * .apiVersions * .resources *",
+		Reason:      "The Operator webhook intercepts requests for every resource in every API group",
+		Remediation: "Limit the webhook rules to the API groups, versions and resources the Operator validates or mutates",
+		Link:        "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/",
+		Kinds:       []string{"ValidatingWebhookConfiguration", "MutatingWebhookConfiguration"},
+		Category:    CategoryAdmission,
+		Points:      -3,
+	}
+	list = append(list, broadWebhookRulesRule)
+
+	// OPR-R43-WH - webhook calls a URL outside the cluster
+	externalWebhookURLRule := Rule{
+		Predicate:   predicate("ExternalWebhookURL"),
+		ID:          "ExternalWebhookURL",
+		Selector:    ".webhooks[] .clientConfig .url",
+		Reason:      "The Operator webhook sends admission requests to a URL outside the cluster rather than an in-cluster Service",
+		Remediation: "Use clientConfig.service to reach an in-cluster Service instead of clientConfig.url",
+		Link:        "https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/",
+		Kinds:       []string{"ValidatingWebhookConfiguration", "MutatingWebhookConfiguration"},
+		Category:    CategoryAdmission,
+		Points:      -3,
+	}
+	list = append(list, externalWebhookURLRule)
+
+	// OPR-R44-RBAC - Role can write leases in kube-system
+	kubeSystemLeasesClusterRoleRule := Rule{
+		Predicate:   predicate("KubeSystemLeasesClusterRole"),
+		ID:          "KubeSystemLeasesClusterRole",
+		Selector:    ".metadata .namespace == kube-system .rules .resources leases .verbs create update patch delete",
+		Reason:      "The Operator SA role can write leases in kube-system, allowing it to hijack control plane leader elections",
+		Remediation: "Run leader election in the Operator namespace and remove write access to leases in kube-system",
+		Link:        "https://kubernetes.io/docs/concepts/architecture/leases/",
+		Kinds:       []string{"Role", "ClusterRole"},
+		Category:    CategoryRBAC,
+		Points:      -16,
+	}
+	list = append(list, kubeSystemLeasesClusterRoleRule)
+
+	// OPR-R45-SC - container has no name
+	unnamedContainerRule := Rule{
+		Predicate:       predicate("UnnamedContainer"),
+		ParsedPredicate: parsedPredicate("UnnamedContainer"),
+		ID:              "UnnamedContainer",
+		Selector:        "containers[] .name == \"\"",
+		Reason:          "A container has no name, so the manifest will be rejected by the API server",
+		Remediation:     "Set a name on every container and init container",
+		Link:            "https://kubernetes.io/docs/concepts/workloads/pods/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, unnamedContainerRule)
+
+	// OPR-R46-SC - capabilities set on the pod securityContext
+	misplacedPodCapabilitiesRule := Rule{
+		Predicate:   predicate("MisplacedPodCapabilities"),
+		ID:          "MisplacedPodCapabilities",
+		Selector:    ".spec .securityContext .capabilities",
+		Reason:      "Capabilities set on the pod securityContext are ignored, they must be set on each container securityContext",
+		Remediation: "Move capabilities from the pod securityContext to each container securityContext",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryCorrectness,
+		Points:      -1,
+	}
+	list = append(list, misplacedPodCapabilitiesRule)
+
+	// OPR-R47-SC - securityContext sets a privileged SELinux type
+	privilegedSELinuxRule := Rule{
+		Predicate:       predicate("PrivilegedSELinux"),
+		ParsedPredicate: parsedPredicate("PrivilegedSELinux"),
+		ID:              "PrivilegedSELinux",
+		Selector:        ".securityContext .seLinuxOptions .type == spc_t",
+		Reason:          "An unconfined SELinux type such as spc_t removes the SELinux confinement of the container",
+		Remediation:     "Remove seLinuxOptions.type, or set it to a confined type such as container_t",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -9,
+	}
+	list = append(list, privilegedSELinuxRule)
+
+	// OPR-R48-RBAC - Role has full permissions over all resources in its namespace
+	starAllRoleRule := Rule{
+		Predicate:       predicate("StarAllRole"),
+		ParsedPredicate: parsedPredicate("StarAllRole"),
+		ID:              "StarAllRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA role has full permissions on all resources in its namespace",
+		Remediation:     "Replace the * apiGroups, resources and verbs with the resources and verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -12,
+	}
+	list = append(list, starAllRoleRule)
+
+	// OPR-R49-RBAC - Role has full permissions over all CoreAPI resources in its namespace
+	starAllCoreAPIRoleRule := Rule{
+		Predicate:       predicate("StarAllCoreAPIRole"),
+		ParsedPredicate: parsedPredicate("StarAllCoreAPIRole"),
+		ID:              "StarAllCoreAPIRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA role has full permissions on all CoreAPI resources in its namespace",
+		Remediation:     "Replace the * resources and verbs on the core API group with the resources and verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -8,
+	}
+	list = append(list, starAllCoreAPIRoleRule)
+
+	// OPR-R50-RBAC - Role has access to secrets in its namespace
+	secretsRoleRule := Rule{
+		Predicate:       predicate("SecretsRole"),
+		ParsedPredicate: parsedPredicate("SecretsRole"),
+		ID:              "SecretsRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA role has access to all secrets in its namespace",
+		Remediation:     "Limit access to the secrets the Operator needs with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -6,
+	}
+	list = append(list, secretsRoleRule)
+
+	// OPR-R51-RBAC - Role can exec into Pods in its namespace
+	execPodsRoleRule := Rule{
+		Predicate:       predicate("ExecPodsRole"),
+		ParsedPredicate: parsedPredicate("ExecPodsRole"),
+		ID:              "ExecPodsRole",
+		Selector:        ".rules .apiGroups .resources .verbs",
+		Reason:          "The Operator SA role has permissions to exec into any pod in its namespace",
+		Remediation:     "Remove the create verb on pods/exec",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -4,
+	}
+	list = append(list, execPodsRoleRule)
+
+	// OPR-R52-SC - large StatefulSet rolls out pods one at a time
+	orderedReadyLargeStatefulSetRule := Rule{
+		Predicate:   predicate("OrderedReadyLargeStatefulSet"),
+		ID:          "OrderedReadyLargeStatefulSet",
+		Selector:    ".spec .replicas > 10 .spec .podManagementPolicy == OrderedReady",
+		Reason:      "A StatefulSet with many replicas and OrderedReady pod management starts and replaces pods one at a time",
+		Remediation: "Set podManagementPolicy: Parallel",
+		Link:        "https://kubernetes.io/docs/concepts/workloads/controllers/statefulset/",
+		Kinds:       []string{"StatefulSet"},
+		Category:    CategoryCorrectness,
+		Points:      -1,
+	}
+	list = append(list, orderedReadyLargeStatefulSetRule)
+
+	// OPR-R53-RBAC - role is bound to the default ServiceAccount
+	defaultServiceAccountBindingRule := Rule{
+		Predicate:   predicate("DefaultServiceAccountBinding"),
+		ID:          "DefaultServiceAccountBinding",
+		Selector:    ".subjects[] .kind == ServiceAccount .name == default",
+		Reason:      "A role is bound to the default ServiceAccount, so every pod in the namespace without its own ServiceAccount inherits it",
+		Remediation: "Bind the role to a dedicated ServiceAccount instead of default",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+		Kinds:       []string{"RoleBinding", "ClusterRoleBinding"},
+		Category:    CategoryRBAC,
+		Points:      -4,
+	}
+	list = append(list, defaultServiceAccountBindingRule)
+
+	// OPR-R54-RBAC - ClusterRole has access to persistent volume claims in all namespaces
+	crossNamespacePVCClusterRoleRule := Rule{
+		Predicate:   predicate("CrossNamespacePVCClusterRole"),
+		ID:          "CrossNamespacePVCClusterRole",
+		Selector:    ".rules .apiGroups .resources persistentvolumeclaims .verbs",
+		Reason:      "The Operator SA cluster role has access to persistent volume claims in every namespace",
+		Remediation: "Use a namespaced Role for persistentvolumeclaims in the namespaces the Operator manages",
+		Link:        "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:       []string{"ClusterRole"},
+		Category:    CategoryRBAC,
+		Points:      -4,
+	}
+	list = append(list, crossNamespacePVCClusterRoleRule)
+
+	// OPR-R55-SC - container allocates a tty without stdin
+	ttyWithoutStdinRule := Rule{
+		Predicate:       predicate("TTYWithoutStdin"),
+		ParsedPredicate: parsedPredicate("TTYWithoutStdin"),
+		ID:              "TTYWithoutStdin",
+		Selector:        "containers[] .tty == true .stdin != true",
+		Reason:          "A container sets tty: true without stdin: true, which has no effect and is usually a copy-paste error",
+		Remediation:     "Remove tty: true, or also set stdin: true",
+		Link:            "https://kubernetes.io/docs/concepts/workloads/pods/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, ttyWithoutStdinRule)
+
+	// OPR-R56-RBAC - Role can create tokens for service accounts in its namespace
+	serviceAccountTokenRoleRule := Rule{
+		Predicate:       predicate("ServiceAccountTokenRole"),
+		ParsedPredicate: parsedPredicate("ServiceAccountTokenRole"),
+		ID:              "ServiceAccountTokenRole",
+		Selector:        ".rules .apiGroups .resources serviceaccounts/token .verbs create",
+		Reason:          "The Operator SA role can create token requests for any service account in its namespace",
+		Remediation:     "Remove the create verb on serviceaccounts/token, or limit it with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -9,
+	}
+	list = append(list, serviceAccountTokenRoleRule)
+
+	// OPR-R57-RBAC - ClusterRole can create pods as any ServiceAccount
+	podCreateArbitrarySARule := Rule{
+		Predicate:       predicate("PodCreateArbitrarySA"),
+		ParsedPredicate: parsedPredicate("PodCreateArbitrarySA"),
+		ID:              "PodCreateArbitrarySA",
+		Selector:        ".rules .apiGroups .resources pods .verbs create",
+		Reason:          "The Operator SA cluster role can create pods, and so run them as any service account in any namespace",
+		Remediation:     "Remove the create verb on pods, or create workloads through a namespaced Role",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
+	}
+	list = append(list, podCreateArbitrarySARule)
+
+	// OPR-R58-RBAC - ClusterRole can read nodes
+	nodesClusterRoleRule := Rule{
+		Predicate:       predicate("NodesClusterRole"),
+		ParsedPredicate: parsedPredicate("NodesClusterRole"),
+		ID:              "NodesClusterRole",
+		Selector:        ".rules .apiGroups .resources nodes .verbs get list",
+		Reason:          "The Operator SA cluster role can read nodes, exposing node addresses, labels and kubelet details",
+		Remediation:     "Remove read access to nodes",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -4,
+	}
+	list = append(list, nodesClusterRoleRule)
+
+	// OPR-R59-SC - volume mounted over the container root filesystem
+	rootVolumeMountRule := Rule{
+		Predicate:       predicate("RootVolumeMount"),
+		ParsedPredicate: parsedPredicate("RootVolumeMount"),
+		ID:              "RootVolumeMount",
+		Selector:        "containers[] .volumeMounts[] .mountPath == /",
+		Reason:          "A volume is mounted at / and replaces the container root filesystem",
+		Remediation:     "Mount the volume at a subdirectory instead of /",
+		Link:            "https://kubernetes.io/docs/concepts/storage/volumes/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -4,
+	}
+	list = append(list, rootVolumeMountRule)
+
+	// OPR-R60-RBAC - ClusterRole can access webhook configurations
+	webhookConfigClusterRoleRule := Rule{
+		Predicate:       predicate("WebhookConfigClusterRole"),
+		ParsedPredicate: parsedPredicate("WebhookConfigClusterRole"),
+		ID:              "WebhookConfigClusterRole",
+		Selector:        ".rules .apiGroups admissionregistration.k8s.io .resources *webhookconfigurations .verbs",
+		Reason:          "The Operator SA cluster role can access admission webhook configurations",
+		Remediation:     "Remove access to webhook configurations, or limit it to the Operator webhooks with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -2,
+	}
+	list = append(list, webhookConfigClusterRoleRule)
+
+	// OPR-R61-SC - workload declares more replicas than allowed
+	excessiveReplicasRule := Rule{
+		Predicate:   predicate("ExcessiveReplicas"),
+		ID:          "ExcessiveReplicas",
+		Selector:    ".spec .replicas > 10",
+		Reason:      "The Operator workload declares more replicas than the allowed maximum",
+		Remediation: "Lower spec.replicas to the allowed maximum",
+		Link:        "https://kubernetes.io/docs/concepts/workloads/controllers/deployment/",
+		Kinds:       []string{"Deployment", "StatefulSet"},
+		Category:    CategoryCorrectness,
+		Points:      -1,
+	}
+	list = append(list, excessiveReplicasRule)
+
+	// OPR-R62-SC - every image is pinned to a tag other than latest or a digest
+	imageTagPinnedRule := Rule{
+		Predicate:       predicate("ImageTagPinned"),
+		ParsedPredicate: parsedPredicate("ImageTagPinned"),
+		ID:              "ImageTagPinned",
+		Selector:        "containers[] .image =~ :tag || @sha256 && !:latest",
+		Reason:          "Pinned images cannot be silently replaced and make the deployed Operator auditable",
+		Remediation:     "Pin every image to a version tag or a digest instead of latest",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, imageTagPinnedRule)
+
+	// OPR-R63-RBAC - ClusterRole can impersonate any identity
+	impersonateAnyIdentityClusterRoleRule := Rule{
+		Predicate:       predicate("ImpersonateAnyIdentityClusterRole"),
+		ParsedPredicate: parsedPredicate("ImpersonateAnyIdentityClusterRole"),
+		ID:              "ImpersonateAnyIdentityClusterRole",
+		Selector:        ".rules .resources users groups serviceaccounts .verbs impersonate",
+		Reason:          "The Operator SA cluster role can impersonate any user, group and service account",
+		Remediation:     "Remove the impersonate verb, or limit it to named identities with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -25,
+	}
+	list = append(list, impersonateAnyIdentityClusterRoleRule)
+
+	// OPR-R64-SC - image has no registry host
+	unqualifiedImageRegistryRule := Rule{
+		Predicate:       predicate("UnqualifiedImageRegistry"),
+		ParsedPredicate: parsedPredicate("UnqualifiedImageRegistry"),
+		ID:              "UnqualifiedImageRegistry",
+		Selector:        "containers[] .image !~ registry/",
+		Reason:          "An image without a registry host is pulled from docker.io, which is unavailable in a private-only environment",
+		Remediation:     "Prefix every image with the private registry host",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, unqualifiedImageRegistryRule)
+
+	// OPR-R65-SC - imagePullPolicy is consistent with the image reference
+	imagePullPolicyRule := Rule{
+		Predicate:       predicate("ImagePullPolicy"),
+		ParsedPredicate: parsedPredicate("ImagePullPolicy"),
+		ID:              "ImagePullPolicy",
+		Selector:        "containers[] .imagePullPolicy != Never && !(Always && :latest)",
+		Reason:          "A pull policy consistent with a pinned image ensures the node runs the image that was reviewed",
+		Remediation:     "Set imagePullPolicy: IfNotPresent for pinned images, or Always for latest",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/#image-pull-policy",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, imagePullPolicyRule)
+
+	// OPR-R66-RBAC - ClusterRole aggregates into the built-in view or edit roles
+	aggregatesIntoViewEditRule := Rule{
+		Predicate:   predicate("AggregatesIntoViewEdit"),
+		ID:          "AggregatesIntoViewEdit",
+		Selector:    ".metadata .labels rbac.authorization.k8s.io/aggregate-to-view || aggregate-to-edit == true",
+		Reason:      "The ClusterRole aggregates into the built-in view or edit roles, granting its rules to every subject bound to them",
+		Remediation: "Remove the rbac.authorization.k8s.io/aggregate-to-view and aggregate-to-edit labels",
+		Link:        "https://kubernetes.io/docs/reference/access-authn-authz/rbac/#aggregated-clusterroles",
+		Kinds:       []string{"ClusterRole"},
+		Category:    CategoryRBAC,
+		Points:      -1,
+	}
+	list = append(list, aggregatesIntoViewEditRule)
+
+	// OPR-R67-SC - securityContext set to runAsGroup > 10000
+	runAsGroupRule := Rule{
+		Predicate:   predicate("RunAsGroup"),
+		ID:          "RunAsGroup",
+		Selector:    "containers[] .securityContext .runAsGroup -gt 10000",
+		Reason:      "Run as a high GID to avoid conflicts with the host's groups",
+		Remediation: "Set securityContext.runAsGroup to a GID above 10000",
+		Link:        "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      1,
+		Advise:      1,
+	}
+	list = append(list, runAsGroupRule)
+
+	// OPR-R68-SC - long-running workload sets a restartPolicy other than Always
+	invalidRestartPolicyRule := Rule{
+		Predicate:   predicate("InvalidRestartPolicy"),
+		ID:          "InvalidRestartPolicy",
+		Selector:    ".spec .template .spec .restartPolicy != Always",
+		Reason:      "Deployment, StatefulSet and DaemonSet pod templates only accept restartPolicy: Always",
+		Remediation: "Remove restartPolicy from the pod template, or set it to Always",
+		Link:        "https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#restart-policy",
+		Kinds:       []string{"Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryCorrectness,
+		Points:      -1,
+	}
+	list = append(list, invalidRestartPolicyRule)
+
+	// OPR-R69-SC - securityContext sets a non-root fsGroup
+	fsGroupRule := Rule{
+		Predicate:       predicate("FsGroup"),
+		ParsedPredicate: parsedPredicate("FsGroup"),
+		ID:              "FsGroup",
+		Selector:        ".spec .securityContext .fsGroup -gt 0",
+		Reason:          "A non-root fsGroup keeps mounted volumes from being owned by the root group",
+		Remediation:     "Set securityContext.fsGroup to a non-zero GID",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, fsGroupRule)
+
+	// OPR-R70-RBAC - ClusterRole can delete collections of sensitive resources
+	deleteCollectionSensitiveClusterRoleRule := Rule{
+		Predicate:       predicate("DeleteCollectionSensitiveClusterRole"),
+		ParsedPredicate: parsedPredicate("DeleteCollectionSensitiveClusterRole"),
+		ID:              "DeleteCollectionSensitiveClusterRole",
+		Selector:        ".rules .resources secrets configmaps workloads .verbs deletecollection",
+		Reason:          "The Operator SA cluster role can delete all secrets, configmaps or workloads of a namespace in a single request",
+		Remediation:     "Remove the deletecollection verb on secrets, configmaps and workloads",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -6,
+	}
+	list = append(list, deleteCollectionSensitiveClusterRoleRule)
+
+	// OPR-R71-SC - containers share the pod process namespace
+	shareProcessNamespaceRule := Rule{
+		Predicate:       predicate("ShareProcessNamespace"),
+		ParsedPredicate: parsedPredicate("ShareProcessNamespace"),
+		ID:              "ShareProcessNamespace",
+		Selector:        ".spec .shareProcessNamespace == true",
+		Reason:          "Containers sharing a process namespace can read each other's memory, environment and file descriptors",
+		Remediation:     "Remove shareProcessNamespace: true",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/share-process-namespace/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -2,
+	}
+	list = append(list, shareProcessNamespaceRule)
+
+	// OPR-R72-SC - securityContext sets unsafe sysctls
+	unsafeSysctlsRule := Rule{
+		Predicate:   predicate("UnsafeSysctls"),
+		ID:          "UnsafeSysctls",
+		Selector:    ".spec .securityContext .sysctls[] .name !~ safe sysctls",
+		Reason:      "Unsafe sysctls are not isolated per pod and can change kernel parameters for the whole node",
+		Remediation: "Remove the unsafe sysctls from securityContext.sysctls",
+		Link:        "https://kubernetes.io/docs/tasks/administer-cluster/sysctl-cluster/",
+		Kinds:       []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:    CategoryPodSecurity,
+		Points:      -9,
+	}
+	list = append(list, unsafeSysctlsRule)
+
+	// OPR-R73-CRD - CRD printer column shows a sensitive field
+	sensitivePrinterColumnsRule := Rule{
+		Predicate:   predicate("SensitivePrinterColumns"),
+		ID:          "SensitivePrinterColumns",
+		Selector:    ".spec .versions[] .additionalPrinterColumns[] .jsonPath =~ password|secret|token",
+		Reason:      "A CRD printer column shows a sensitive field in kubectl get output",
+		Remediation: "Remove the additionalPrinterColumns that show sensitive fields",
+		Link:        "https://kubernetes.io/docs/tasks/extend-kubernetes/custom-resources/custom-resource-definitions/",
+		Kinds:       []string{"CustomResourceDefinition"},
+		Category:    CategoryCorrectness,
+		Points:      -1,
+	}
+	list = append(list, sensitivePrinterColumnsRule)
+
+	// OPR-R75-SC - securityContext set to procMount: Unmasked
+	procMountUnmaskedRule := Rule{
+		Predicate:       predicate("ProcMountUnmasked"),
+		ParsedPredicate: parsedPredicate("ProcMountUnmasked"),
+		ID:              "ProcMountUnmasked",
+		Selector:        ".spec .containers[] .securityContext .procMount == Unmasked",
+		Reason:          "An unmasked /proc exposes kernel interfaces that are normally hidden from containers",
+		Remediation:     "Remove securityContext.procMount, or set it to Default",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -9,
+	}
+	list = append(list, procMountUnmaskedRule)
+
+	// OPR-R76-SC - container binds a hostPort
+	hostPortRule := Rule{
+		Predicate:       predicate("HostPort"),
+		ParsedPredicate: parsedPredicate("HostPort"),
+		ID:              "HostPort",
+		Selector:        ".spec .containers[] .ports[] .hostPort",
+		Reason:          "A hostPort reserves a port on the node and exposes the container outside Service and NetworkPolicy controls",
+		Remediation:     "Remove hostPort and expose the container through a Service",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -4,
+	}
+	list = append(list, hostPortRule)
+
+	// OPR-R77-SC - env exposes node information through the downward API
+	downwardEnvNodeInfoRule := Rule{
+		Predicate:       predicate("DownwardEnvNodeInfo"),
+		ParsedPredicate: parsedPredicate("DownwardEnvNodeInfo"),
+		ID:              "DownwardEnvNodeInfo",
+		Selector:        ".spec .containers[] .env[] .valueFrom .fieldRef .fieldPath == spec.nodeName",
+		Reason:          "Exposing the node name or host IP to the container helps an attacker target the node it runs on",
+		Remediation:     "Remove the spec.nodeName and status.hostIP fieldRefs from env",
+		Link:            "https://kubernetes.io/docs/tasks/inject-data-application/environment-variable-expose-pod-information/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -1,
+	}
+	list = append(list, downwardEnvNodeInfoRule)
+
+	// OPR-R78-SC - memory-backed emptyDir volumes set a sizeLimit
+	memoryEmptyDirSizeLimitRule := Rule{
+		Predicate:       predicate("MemoryEmptyDirSizeLimit"),
+		ParsedPredicate: parsedPredicate("MemoryEmptyDirSizeLimit"),
+		ID:              "MemoryEmptyDirSizeLimit",
+		Selector:        ".spec .volumes[] .emptyDir .medium == Memory .sizeLimit",
+		Reason:          "A sizeLimit on a memory-backed emptyDir stops it from exhausting node memory",
+		Remediation:     "Set sizeLimit on every emptyDir with medium: Memory",
+		Link:            "https://kubernetes.io/docs/concepts/storage/volumes/#emptydir",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, memoryEmptyDirSizeLimitRule)
+
+	// OPR-R79-SC - pod activeDeadlineSeconds is so large it is effectively no deadline
+	excessivePodDeadlineRule := Rule{
+		Predicate:       predicate("ExcessivePodDeadline"),
+		ParsedPredicate: parsedPredicate("ExcessivePodDeadline"),
+		ID:              "ExcessivePodDeadline",
+		Selector:        ".spec .activeDeadlineSeconds -gt MaxPodActiveDeadlineSeconds",
+		Reason:          "An activeDeadlineSeconds this large never fires and can hide runaway pods",
+		Remediation:     "Lower activeDeadlineSeconds to the longest time the pod should run",
+		Link:            "https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet", "Job"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, excessivePodDeadlineRule)
+
+	// OPR-R80-SC - image uses a placeholder or zero-version tag
+	placeholderImageTagRule := Rule{
+		Predicate:       predicate("PlaceholderImageTag"),
+		ParsedPredicate: parsedPredicate("PlaceholderImageTag"),
+		ID:              "PlaceholderImageTag",
+		Selector:        ".spec .containers[] .image == *:v0 *:0.0.0 *:dev *:test *:TODO",
+		Reason:          "A placeholder or zero-version image tag usually means a development build was shipped by mistake",
+		Remediation:     "Replace the placeholder tag with the version of a release build",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, placeholderImageTagRule)
+
+	// OPR-R81-SC - securityContext adds dangerous Linux capabilities
+	dangerousCapabilitiesRule := Rule{
+		Predicate:       predicate("DangerousCapabilities"),
+		ParsedPredicate: parsedPredicate("DangerousCapabilities"),
+		ID:              "DangerousCapabilities",
+		Selector:        "containers[] .securityContext .capabilities .add == NET_ADMIN SYS_PTRACE SYS_MODULE DAC_OVERRIDE SYS_ADMIN",
+		Reason:          "Capabilities such as NET_ADMIN, SYS_PTRACE and SYS_MODULE let a container reconfigure or escape to the host",
+		Remediation:     "Remove NET_ADMIN, SYS_PTRACE, SYS_MODULE, DAC_OVERRIDE and SYS_ADMIN from securityContext.capabilities.add",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -4,
+		ScalePoints:     true,
+	}
+	list = append(list, dangerousCapabilitiesRule)
+
+	// OPR-R82-RBAC - ClusterRole can modify resourcequotas or limitranges
+	quotaWriteClusterRoleRule := Rule{
+		Predicate:       predicate("QuotaWriteClusterRole"),
+		ParsedPredicate: parsedPredicate("QuotaWriteClusterRole"),
+		ID:              "QuotaWriteClusterRole",
+		Selector:        ".rules .resources resourcequotas limitranges .verbs create update patch delete",
+		Reason:          "The Operator SA cluster role can change resource quotas and limit ranges, disabling resource governance",
+		Remediation:     "Remove the write verbs on resourcequotas and limitranges",
+		Link:            "https://kubernetes.io/docs/concepts/policy/resource-quotas/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -1,
+	}
+	list = append(list, quotaWriteClusterRoleRule)
+
+	// OPR-R83-SC - Secret is mounted into many containers of the pod
+	secretMountedEverywhereRule := Rule{
+		Predicate:       predicate("SecretMountedEverywhere"),
+		ParsedPredicate: parsedPredicate("SecretMountedEverywhere"),
+		ID:              "SecretMountedEverywhere",
+		Selector:        ".spec .containers[] .volumeMounts[] == .spec .volumes[] .secret",
+		Reason:          "A Secret mounted into many containers is exposed if any one of them is compromised",
+		Remediation:     "Mount the Secret only into the containers that use it",
+		Link:            "https://kubernetes.io/docs/concepts/security/secrets-good-practices/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -1,
+	}
+	list = append(list, secretMountedEverywhereRule)
+
+	// OPR-R84-RBAC - role can escalate roles and create rolebindings
+	namespacedSelfGrantRule := Rule{
+		Predicate:   predicate("NamespacedSelfGrant"),
+		ID:          "NamespacedSelfGrant",
+		Selector:    ".rules .resources roles .verbs escalate && .resources rolebindings .verbs create",
+		Reason:      "The Operator SA role can escalate roles and create rolebindings, so it can grant itself any namespaced permission",
+		Remediation: "Remove the escalate verb on roles, or the create verb on rolebindings",
+		Link:        "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:       []string{"Role", "ClusterRole"},
+		Category:    CategoryRBAC,
+		Points:      -9,
+	}
+	list = append(list, namespacedSelfGrantRule)
+
+	// OPR-R85-SC - pod explicitly sets automountServiceAccountToken: true
+	explicitTokenAutomountRule := Rule{
+		Predicate:       predicate("ExplicitTokenAutomount"),
+		ParsedPredicate: parsedPredicate("ExplicitTokenAutomount"),
+		ID:              "ExplicitTokenAutomount",
+		Selector:        ".spec .automountServiceAccountToken == true",
+		Reason:          "Explicitly mounting the service account token shows the Operator relies on it, so it is a target if the pod is compromised",
+		Remediation:     "Set automountServiceAccountToken: false unless the Operator calls the Kubernetes API",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -1,
+	}
+	list = append(list, explicitTokenAutomountRule)
+
+	// OPR-R86-SC - pod runs more containers than allowed
+	tooManyContainersRule := Rule{
+		Predicate:       predicate("TooManyContainers"),
+		ParsedPredicate: parsedPredicate("TooManyContainers"),
+		ID:              "TooManyContainers",
+		Selector:        ".spec .containers[] .initContainers[] -gt DefaultMaxContainers",
+		Reason:          "Every extra sidecar adds attack surface and makes the Operator pod harder to audit",
+		Remediation:     "Remove sidecars the Operator does not need",
+		Link:            "https://kubernetes.io/docs/concepts/workloads/pods/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, tooManyContainersRule)
+
+	// OPR-R87-SC - DaemonSet uses the host network on every node
+	hostNetworkDaemonSetRule := Rule{
+		Predicate:       predicate("HostNetworkDaemonSet"),
+		ParsedPredicate: parsedPredicate("HostNetworkDaemonSet"),
+		ID:              "HostNetworkDaemonSet",
+		Selector:        "DaemonSet .spec .hostNetwork == true",
+		Reason:          "A DaemonSet on the host network shares the network namespace of every node in the cluster",
+		Remediation:     "Remove hostNetwork: true from the DaemonSet",
+		Link:            "https://kubernetes.io/docs/concepts/security/pod-security-standards/",
+		Kinds:           []string{"DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -9,
+	}
+	list = append(list, hostNetworkDaemonSetRule)
+
+	// OPR-R89-RBAC - ClusterRole can bind the built-in aggregated roles
+	bindAggregateRolesClusterRoleRule := Rule{
+		Predicate:       predicate("BindAggregateRolesClusterRole"),
+		ParsedPredicate: parsedPredicate("BindAggregateRolesClusterRole"),
+		ID:              "BindAggregateRolesClusterRole",
+		Selector:        ".rules .resources clusterroles .verbs bind .resourceNames admin edit view",
+		Reason:          "The Operator SA cluster role can bind the built-in aggregated roles, whose permissions grow with every role aggregated into them",
+		Remediation:     "Remove admin, edit, view and the system:aggregate-to-* roles from the bind resourceNames",
+		Link:            "https://kubernetes.io/docs/reference/access-authn-authz/rbac/#aggregated-clusterroles",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
+	}
+	list = append(list, bindAggregateRolesClusterRoleRule)
+
+	// OPR-R90-SC - securityContext sets runAsNonRoot: true with runAsUser: 0
+	nonRootWithRootUIDRule := Rule{
+		Predicate:       predicate("NonRootWithRootUID"),
+		ParsedPredicate: parsedPredicate("NonRootWithRootUID"),
+		ID:              "NonRootWithRootUID",
+		Selector:        ".securityContext .runAsNonRoot == true .runAsUser == 0",
+		Reason:          "A container requires a non-root user but runs as UID 0, so the kubelet will refuse to start it",
+		Remediation:     "Set securityContext.runAsUser to a non-zero UID, or remove runAsNonRoot: true",
+		Link:            "https://kubernetes.io/docs/tasks/configure-pod-container/security-context/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryCorrectness,
+		Points:          -1,
+	}
+	list = append(list, nonRootWithRootUIDRule)
+
+	// OPR-R92-SC - CronJob allows concurrent runs of a privileged pod
+	concurrentPrivilegedCronJobRule := Rule{
+		Predicate:   predicate("ConcurrentPrivilegedCronJob"),
+		ID:          "ConcurrentPrivilegedCronJob",
+		Selector:    ".spec .concurrencyPolicy == Allow .jobTemplate .containers[] .securityContext .privileged == true",
+		Reason:      "Concurrent runs of a privileged CronJob multiply the privileged pods an adversary can compromise at once",
+		Remediation: "Set concurrencyPolicy: Forbid or Replace on the CronJob",
+		Link:        "https://kubernetes.io/docs/concepts/workloads/controllers/cron-jobs/",
+		Kinds:       []string{"CronJob"},
+		Category:    CategoryPodSecurity,
+		Points:      -4,
+	}
+	list = append(list, concurrentPrivilegedCronJobRule)
+
+	// OPR-R93-SC - container command or args embed a credential
+	credentialInArgsRule := Rule{
+		Predicate:       predicate("CredentialInArgs"),
+		ParsedPredicate: parsedPredicate("CredentialInArgs"),
+		ID:              "CredentialInArgs",
+		Selector:        "containers[] .command .args --token= --password=",
+		Reason:          "Credentials passed as command line arguments are visible in process listings, logs and the workload manifest",
+		Remediation:     "Mount the credential from a Secret as a file or env var instead of passing it in command or args",
+		Link:            "https://kubernetes.io/docs/concepts/security/secrets-good-practices/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          -9,
+	}
+	list = append(list, credentialInArgsRule)
+
+	// OPR-R95-RBAC - ClusterRole can create or modify ClusterRoleBindings
+	modifyClusterRoleBindingsClusterRoleRule := Rule{
+		Predicate:       predicate("ModifyClusterRoleBindingsClusterRole"),
+		ParsedPredicate: parsedPredicate("ModifyClusterRoleBindingsClusterRole"),
+		ID:              "ModifyClusterRoleBindingsClusterRole",
+		Selector:        ".rules .resources clusterrolebindings .verbs create update patch",
+		Reason:          "The Operator SA cluster role can create or modify ClusterRoleBindings, so it can grant its permissions to any subject cluster-wide",
+		Remediation:     "Remove the create, update and patch verbs on clusterrolebindings, or limit update and patch to named bindings with resourceNames",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -16,
+	}
+	list = append(list, modifyClusterRoleBindingsClusterRoleRule)
+
+	// OPR-R96-RBAC - Role has escalate permissions in its namespace
+	escalateRoleRule := Rule{
+		Predicate:       predicate("EscalateRole"),
+		ParsedPredicate: parsedPredicate("EscalateRole"),
+		ID:              "EscalateRole",
+		Selector:        ".rules .apiGroups rbac.authorization.k8s.io .resources roles .verbs escalate",
+		Reason:          "The Operator SA role has escalate permissions in its namespace",
+		Remediation:     "Remove the escalate verb on roles",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"Role"},
+		Category:        CategoryRBAC,
+		Points:          -12,
+	}
+	list = append(list, escalateRoleRule)
+
+	// OPR-R97-RBAC - ClusterRole or Role grants all verbs on sensitive resources
+	wildcardVerbsOnSensitiveResourcesRule := Rule{
+		Predicate:       predicate("WildcardVerbsOnSensitiveResources"),
+		ParsedPredicate: parsedPredicate("WildcardVerbsOnSensitiveResources"),
+		ID:              "WildcardVerbsOnSensitiveResources",
+		Selector:        ".rules .resources secrets configmaps serviceaccounts .verbs *",
+		Reason:          "The Operator SA role grants all verbs on secrets, configmaps or service accounts",
+		Remediation:     "Replace the * verbs on secrets, configmaps and serviceaccounts with the verbs the Operator uses",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole", "Role"},
+		Category:        CategoryRBAC,
+		Points:          -9,
+	}
+	list = append(list, wildcardVerbsOnSensitiveResourcesRule)
+
+	// OPR-R98-RBAC - ClusterRole can read nodes/proxy
+	nodeProxyReadClusterRoleRule := Rule{
+		Predicate:       predicate("NodeProxyReadClusterRole"),
+		ParsedPredicate: parsedPredicate("NodeProxyReadClusterRole"),
+		ID:              "NodeProxyReadClusterRole",
+		Selector:        ".rules .apiGroups .resources nodes/proxy .verbs get list",
+		Reason:          "The Operator SA cluster role can read nodes/proxy, which reaches the kubelet API of every node including pod logs, metrics and running pod details",
+		Remediation:     "Remove read access to nodes/proxy, read node metrics through the metrics API instead",
+		Link:            "https://kubernetes.io/docs/concepts/security/rbac-good-practices/",
+		Kinds:           []string{"ClusterRole"},
+		Category:        CategoryRBAC,
+		Points:          -9,
+	}
+	list = append(list, nodeProxyReadClusterRoleRule)
+
+	// OPR-R99-SC - internal registry images are pinned by digest
+	internalRegistryDigestRule := Rule{
+		Predicate:       predicate("InternalRegistryDigest"),
+		ParsedPredicate: parsedPredicate("InternalRegistryDigest"),
+		ID:              "InternalRegistryDigest",
+		Selector:        "containers[] .image =~ internal registry && @sha256",
+		Reason:          "Images from the internal build registry are pinned by digest, so the Operator runs exactly the image that was built and reviewed",
+		Remediation:     "Pin images from the internal registry by digest with @sha256:",
+		Link:            "https://kubernetes.io/docs/concepts/containers/images/",
+		Kinds:           []string{"Pod", "Deployment", "StatefulSet", "DaemonSet"},
+		Category:        CategoryPodSecurity,
+		Points:          1,
+		Advise:          1,
+	}
+	list = append(list, internalRegistryDigestRule)
+
+	list = append(list, extra...)
+
 	return &Ruleset{
-		Rules:  list,
-		logger: logger,
+		Rules:          list,
+		AggregateRules: defaultAggregateRules(),
+		Concurrency:    runtime.NumCPU(),
+		logger:         logger,
+	}
+}
+
+// NewRulesetWithLogger is NewRuleset for a structured zap logger, such as one built
+// with zap.NewProduction for JSON logs
+func NewRulesetWithLogger(logger *zap.Logger, extra ...Rule) *Ruleset {
+	return NewRuleset(logger.Sugar(), extra...)
+}
+
+// predicate resolves a predicate registered in rules.Registry. The default rules
+// are built by name, so a missing registration fails as soon as a ruleset is created.
+func predicate(name string) func([]byte) int {
+	p, ok := rules.Lookup(name)
+	if !ok {
+		panic(fmt.Sprintf("predicate %s is not registered", name))
+	}
+	return p
+}
+
+// parsedPredicate resolves the variant of a registered predicate in rules.ParsedRegistry
+// that evaluates the document the ruleset has already parsed
+func parsedPredicate(name string) func(map[string]interface{}) int {
+	p, ok := rules.LookupParsed(name)
+	if !ok {
+		panic(fmt.Sprintf("parsed predicate %s is not registered", name))
+	}
+	return p
+}
+
+// UseExplicitReadOnlyRootFilesystem restores the previous ReadOnlyRootFilesystem behaviour,
+// which only counts regular containers that explicitly set readOnlyRootFilesystem: false
+func (rs *Ruleset) UseExplicitReadOnlyRootFilesystem() {
+	for i := range rs.Rules {
+		if rs.Rules[i].ID == "ReadOnlyRootFilesystem" {
+			rs.Rules[i].Predicate = predicate("ReadOnlyRootFilesystemExplicit")
+			rs.Rules[i].ParsedPredicate = nil
+			rs.Rules[i].Selector = ".spec .containers[] .securityContext .readOnlyRootFilesystem == false"
+		}
+	}
+}
+
+// privilegedDetailed notes in the reason when privileged matches come from init containers
+func privilegedDetailed(json []byte) (int, string) {
+	containers, initContainers := rules.PrivilegedDetailed(json)
+
+	switch {
+	case initContainers == 0:
+		return containers, ""
+	case initContainers == containers:
+		return containers, "matched in init containers"
+	default:
+		return containers, fmt.Sprintf("%v of %v matches in init containers", initContainers, containers)
 	}
 }
 
 func (rs *Ruleset) Run(fileName string, fileBytes []byte, schemaDir string) ([]Report, error) {
+	return rs.RunContext(context.Background(), fileName, fileBytes, schemaDir)
+}
+
+// RunContext is Run but stops evaluating documents and rules once ctx is done,
+// returning ctx.Err()
+func (rs *Ruleset) RunContext(ctx context.Context, fileName string, fileBytes []byte, schemaDir string) ([]Report, error) {
 	reports := make([]Report, 0)
+	docs := make([][]byte, 0)
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
 
 	isJSON := json.Valid(fileBytes)
 	if isJSON {
-		report := rs.generateReport(fileName, fileBytes, schemaDir)
+		report, err := rs.generateReportContext(ctx, fileName, fileBytes, schemaDir)
+		if err != nil {
+			return nil, err
+		}
 		reports = append(reports, report)
 	} else {
 		lineBreak := detectLineBreak(fileBytes)
@@ -346,11 +1509,29 @@ func (rs *Ruleset) Run(fileName string, fileBytes []byte, schemaDir string) ([]R
 				rs.logger.Debugf("empty but still more docs, continuing")
 				continue
 			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
 			data, err := yaml.YAMLToJSON(doc)
 			if err != nil {
 				return reports, err
 			}
-			report := rs.generateReport(fileName, data, schemaDir)
+			report, err := rs.generateReportContext(ctx, fileName, data, schemaDir)
+			if err != nil {
+				return nil, err
+			}
+			reports = append(reports, report)
+			docs = append(docs, data)
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// cross-document rules only apply to bundles of more than one object
+	if len(docs) > 1 {
+		if report, matched := rs.generateBundleReport(fileName, reports, docs); matched {
 			reports = append(reports, report)
 		}
 	}
@@ -358,6 +1539,21 @@ func (rs *Ruleset) Run(fileName string, fileBytes []byte, schemaDir string) ([]R
 	return reports, nil
 }
 
+// RunReader reads a stream of manifests fully and runs the ruleset over it. The
+// fileName is only used to label the reports and defaults to "-".
+func (rs *Ruleset) RunReader(fileName string, r io.Reader, schemaDir string) ([]Report, error) {
+	if fileName == "" {
+		fileName = "-"
+	}
+
+	fileBytes, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return rs.Run(fileName, fileBytes, schemaDir)
+}
+
 func appendUniqueRule(uniqueRules []RuleRef, newRule RuleRef) []RuleRef {
 	if !containsRule(uniqueRules[:], newRule) {
 		uniqueRules = append(uniqueRules, newRule)
@@ -375,6 +1571,11 @@ func containsRule(rules []RuleRef, newRule RuleRef) bool {
 }
 
 func (rs *Ruleset) generateReport(fileName string, json []byte, schemaDir string) Report {
+	report, _ := rs.generateReportContext(context.Background(), fileName, json, schemaDir)
+	return report
+}
+
+func (rs *Ruleset) generateReportContext(ctx context.Context, fileName string, json []byte, schemaDir string) (Report, error) {
 	report := Report{
 		Object:   "Unknown",
 		FileName: fileName,
@@ -384,104 +1585,174 @@ func (rs *Ruleset) generateReport(fileName string, json []byte, schemaDir string
 			Advise:   make([]RuleRef, 0),
 			Passed:   make([]RuleRef, 0),
 			Critical: make([]RuleRef, 0),
+			Ignored:  make([]RuleRef, 0),
 		},
 	}
 
 	report.Object = getObjectName(json)
 
-	// KGW removed kubeval due to out of date schema validation breaking rule checks
-
-	// validate resource with kubeval
-	// cfg := kubeval.NewDefaultConfig()
-	// cfg.FileName = fileName
-	// cfg.Strict = true
-
-	// if schemaDir != "" {
-	// 	cfg.SchemaLocation = "file://" + schemaDir
-	// } else if _, err := os.Stat("/schemas/kubernetes-json-schema/master/master-standalone"); !os.IsNotExist(err) {
-	// 	cfg.SchemaLocation = "file:///schemas"
-	// }
-
-	// results, err := kubeval.Validate(json, cfg)
-	// if err != nil {
-	// 	if strings.Contains(err.Error(), "404 Not Found") {
-	// 		report.Message = "This resource is invalid, unknown schema"
-	// 	} else {
-	// 		report.Message = err.Error()
-	// 	}
-	// 	return report
-	// }
-
-	// for _, result := range results {
-	// 	if len(result.Errors) > 0 {
-	// 		for _, desc := range result.Errors {
-	// 			report.Message += desc.String() + " "
-	// 		}
-	// 	} else if result.Kind == "" {
-	// 		report.Message += "This resource is invalid, Kubernetes kind not found"
-	// 	}
-	// }
-
-	// if len(report.Message) > 0 {
-	// 	return report
-	// }
+	// validate the resource against the schemas in schemaDir, if any
+	if schemaDir != "" {
+		if message := validateSchema(json, schemaDir); message != "" {
+			report.Message = message
+			return report, nil
+		}
+	}
 	report.Valid = true
 
-	// run rules in parallel
+	// run rules in parallel, bounded by the ruleset concurrency
+	concurrency := rs.Concurrency
+	if concurrency < 1 {
+		concurrency = runtime.NumCPU()
+	}
+
+	// parse the document once and share it across rules
+	doc := parseDocument(json)
+
 	ch := make(chan RuleRef, len(rs.Rules))
+	sem := make(chan struct{}, concurrency)
 	var wg sync.WaitGroup
+DISPATCH:
 	for _, rule := range rs.Rules {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break DISPATCH
+		}
+
 		wg.Add(1)
-		go eval(json, rule, ch, &wg)
+		go func(rule Rule) {
+			defer func() { <-sem }()
+			eval(json, doc, rule, ch, &wg)
+		}(rule)
 	}
 	wg.Wait()
 	close(ch)
 
-	// collect results
+	if err := ctx.Err(); err != nil {
+		return report, err
+	}
+
+	// collect results, setting aside the matched rules the object asks to ignore
+	ignored := ignoredRules(doc)
 	var appliedRules int
 	for ruleRef := range ch {
 		appliedRules++
+		if ruleRef.Containers > 0 && ignored[ruleRef.ID] {
+			rs.logger.Debugw("ignoring matched rule", "rule_id", ruleRef.ID, "object", report.Object)
+			report.Rules = appendUniqueRule(report.Rules, ruleRef)
+			report.Scoring.Ignored = append(report.Scoring.Ignored, ruleRef)
+			continue
+		}
+		rs.scoreRule(&report, ruleRef)
+	}
 
-		report.Rules = appendUniqueRule(report.Rules, ruleRef)
+	if appliedRules < 1 {
+		report.Message = "This resource kind is not supported by badrobot"
+	} else {
+		setScoreMessage(&report, rs.Threshold)
+	}
 
-		if ruleRef.Containers > 0 {
-			if ruleRef.Points >= 0 {
-				rs.logger.Debugf("positive score rule matched %v (%v points)", ruleRef.Selector, ruleRef.Points)
-				report.Score += ruleRef.Points
-				report.Scoring.Passed = append(report.Scoring.Passed, ruleRef)
-			}
+	sortScoring(&report)
 
-			if ruleRef.Points < 0 {
-				rs.logger.Debugf("negative score rule matched %v (%v points)", ruleRef.Selector, ruleRef.Points)
-				report.Score += ruleRef.Points
-				report.Scoring.Critical = append(report.Scoring.Critical, ruleRef)
+	return report, nil
+}
+
+// scoreRule records an evaluated rule against the report and updates its score
+func (rs *Ruleset) scoreRule(report *Report, ruleRef RuleRef) {
+	report.Rules = appendUniqueRule(report.Rules, ruleRef)
+
+	if ruleRef.Containers > 0 {
+		if ruleRef.Points >= 0 {
+			report.Score += rs.points(ruleRef)
+			rs.logger.Debugw("positive score rule matched", ruleFields(report, ruleRef)...)
+			report.Scoring.Passed = append(report.Scoring.Passed, ruleRef)
+		}
+
+		if ruleRef.Points < 0 {
+			if !ruleRef.Advisory {
+				report.Score += rs.points(ruleRef)
 			}
-		} else if ruleRef.Points >= 0 {
-			rs.logger.Debugf("positive score rule failed %v (%v points)", ruleRef.Selector, ruleRef.Points)
-			report.Scoring.Advise = append(report.Scoring.Advise, ruleRef)
+			rs.logger.Debugw("negative score rule matched", ruleFields(report, ruleRef)...)
+			report.Scoring.Critical = append(report.Scoring.Critical, ruleRef)
 		}
+	} else if ruleRef.Points >= 0 && ruleRef.Containers != rules.NotApplicable {
+		rs.logger.Debugw("positive score rule failed", ruleFields(report, ruleRef)...)
+		report.Scoring.Advise = append(report.Scoring.Advise, ruleRef)
 	}
+}
 
-	if appliedRules < 1 {
-		report.Message = "This resource kind is not supported by badrobot"
-	} else if report.Score >= 0 {
+// ruleFields are the structured log fields of a scored rule
+func ruleFields(report *Report, ruleRef RuleRef) []interface{} {
+	return []interface{}{
+		"rule_id", ruleRef.ID,
+		"object", report.Object,
+		"selector", ruleRef.Selector,
+		"points", ruleRef.Points,
+		"score", report.Score,
+	}
+}
+
+// points returns the score contribution of a matched rule, scaled by its weight
+// when weighted scoring is on. An unset weight counts as 1.
+func (rs *Ruleset) points(ruleRef RuleRef) int {
+	if !rs.WeightedScoring || ruleRef.Weight == 0 {
+		return ruleRef.Points
+	}
+
+	return ruleRef.Points * ruleRef.Weight
+}
+
+func setScoreMessage(report *Report, threshold int) {
+	if report.Passed(threshold) {
 		report.Message = fmt.Sprintf("Passed with a score of %v points", report.Score)
 	} else {
 		report.Message = fmt.Sprintf("Failed with a score of %v points", report.Score)
 	}
+}
 
-	// sort results into priority order
+// sortScoring sorts results into priority order
+func sortScoring(report *Report) {
 	sort.Sort(RuleRefCustomOrder(report.Scoring.Critical))
 	sort.Sort(RuleRefCustomOrder(report.Scoring.Passed))
 	sort.Sort(RuleRefCustomOrder(report.Scoring.Advise))
+	sort.Sort(RuleRefCustomOrder(report.Scoring.Ignored))
+}
 
-	return report
+// IgnoreAnnotation lists the comma-separated IDs of rules whose matches are accepted
+// for an object, they are reported as ignored and do not count towards the score
+const IgnoreAnnotation = "badrobot.controlplane.io/ignore"
+
+// ignoredRules returns the rule IDs listed in the ignore annotation of a parsed document
+func ignoredRules(doc map[string]interface{}) map[string]bool {
+	ignored := make(map[string]bool)
+
+	metadata, ok := doc["metadata"].(map[string]interface{})
+	if !ok {
+		return ignored
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return ignored
+	}
+	value, ok := annotations[IgnoreAnnotation].(string)
+	if !ok {
+		return ignored
+	}
+
+	for _, id := range strings.Split(value, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ignored[id] = true
+		}
+	}
+
+	return ignored
 }
 
-func eval(json []byte, rule Rule, ch chan RuleRef, wg *sync.WaitGroup) {
+func eval(json []byte, doc map[string]interface{}, rule Rule, ch chan RuleRef, wg *sync.WaitGroup) {
 	defer wg.Done()
 
-	containers, err := rule.Eval(json)
+	containers, detail, err := rule.evalParsedDetailed(json, doc)
 
 	// skip rule if it doesn't apply to object kind
 	switch err.(type) {
@@ -489,31 +1760,83 @@ func eval(json []byte, rule Rule, ch chan RuleRef, wg *sync.WaitGroup) {
 		return
 	}
 
+	reason := rule.Reason
+	if containers > 0 && detail != "" {
+		reason += " (" + detail + ")"
+	}
+
+	points := rule.Points
+	if rule.ScalePoints && containers > 0 {
+		points *= containers
+	}
+
 	result := RuleRef{
-		Containers: containers,
-		ID:         rule.ID,
-		Points:     rule.Points,
-		Reason:     rule.Reason,
-		Selector:   rule.Selector,
-		Weight:     rule.Weight,
-		Link:       rule.Link,
+		Containers:  containers,
+		ID:          rule.ID,
+		Points:      points,
+		Reason:      reason,
+		Remediation: rule.Remediation,
+		Selector:    rule.Selector,
+		Weight:      rule.Weight,
+		Link:        rule.Link,
+		Category:    rule.Category,
+		Severity:    severityFor(rule.Points),
 	}
 
 	ch <- result
 }
 
-// getObjectName returns <kind>/<name>.<namespace>
+// parseDocument unmarshals a JSON document, returning nil if it is not a JSON object
+func parseDocument(data []byte) map[string]interface{} {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil
+	}
+	return doc
+}
+
+// clusterScopedKinds are the built-in kinds that are not namespaced
+var clusterScopedKinds = map[string]bool{
+	"APIService":                       true,
+	"CertificateSigningRequest":        true,
+	"ClusterRole":                      true,
+	"ClusterRoleBinding":               true,
+	"CSIDriver":                        true,
+	"CSINode":                          true,
+	"CustomResourceDefinition":         true,
+	"IngressClass":                     true,
+	"MutatingWebhookConfiguration":     true,
+	"Namespace":                        true,
+	"Node":                             true,
+	"PersistentVolume":                 true,
+	"PodSecurityPolicy":                true,
+	"PriorityClass":                    true,
+	"RuntimeClass":                     true,
+	"StorageClass":                     true,
+	"ValidatingAdmissionPolicy":        true,
+	"ValidatingAdmissionPolicyBinding": true,
+	"ValidatingWebhookConfiguration":   true,
+	"VolumeAttachment":                 true,
+}
+
+// IsClusterScoped reports whether kind is a built-in kind that is not namespaced
+func IsClusterScoped(kind string) bool {
+	return clusterScopedKinds[kind]
+}
+
+// getObjectName returns <kind>/<name>.<namespace>, or <kind>/<name> for cluster-scoped kinds
 func getObjectName(json []byte) string {
 	jq := gojsonq.New().Reader(bytes.NewReader(json))
 	if len(jq.Errors()) > 0 {
 		return "Unknown"
 	}
 
-	kind := jq.Copy().From("kind").Get()
-	if kind == nil {
+	kindValue := jq.Copy().From("kind").Get()
+	if kindValue == nil {
 		return "Unknown"
 	}
-	object := fmt.Sprintf("%v", kind)
+	kind := fmt.Sprintf("%v", kindValue)
+	object := kind
 
 	name := jq.Copy().From("metadata.name").Get()
 	if name == nil {
@@ -522,6 +1845,11 @@ func getObjectName(json []byte) string {
 		object += fmt.Sprintf("/%v", name)
 	}
 
+	// cluster-scoped objects have no namespace
+	if clusterScopedKinds[kind] {
+		return object
+	}
+
 	namespace := jq.Copy().From("metadata.namespace").Get()
 	if namespace == nil {
 		object += ".default"
@@ -532,9 +1860,10 @@ func getObjectName(json []byte) string {
 	return object
 }
 
+// detectLineBreak returns the line ending used by the file, independent of the host OS
 func detectLineBreak(haystack []byte) string {
 	windowsLineEnding := bytes.Contains(haystack, []byte("\r\n"))
-	if windowsLineEnding && runtime.GOOS == "windows" {
+	if windowsLineEnding {
 		return "\r\n"
 	}
 	return "\n"
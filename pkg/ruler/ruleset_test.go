@@ -1,11 +1,21 @@
 package ruler
 
 import (
-	// "strings"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/controlplaneio/badrobot/pkg/rules"
 	"github.com/ghodss/yaml"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
 )
 
 const schemaDir = ""
@@ -35,3 +45,778 @@ metadata:
 		t.Errorf("Got score %v wanted a negative value", report.Score)
 	}
 }
+
+func TestRuleset_RunReader(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).RunReader("", strings.NewReader(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(reports) != 3 {
+		t.Fatalf("Got %v reports wanted %v", len(reports), 3)
+	}
+
+	for _, report := range reports {
+		if report.FileName != "-" {
+			t.Errorf("Got file name %v wanted %v", report.FileName, "-")
+		}
+	}
+}
+
+func TestRuleset_Run_WindowsLineEndings(t *testing.T) {
+	var data = strings.Join([]string{
+		"---",
+		"apiVersion: v1",
+		"kind: Namespace",
+		"metadata:",
+		"  name: operator-system",
+		"---",
+		"apiVersion: v1",
+		"kind: ServiceAccount",
+		"metadata:",
+		"  name: controller-manager",
+		"  namespace: operator-system",
+		"",
+	}, "\r\n")
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(reports) != 2 {
+		t.Fatalf("Got %v reports wanted %v", len(reports), 2)
+	}
+
+	if reports[0].Object != "Namespace/operator-system" {
+		t.Errorf("Got object %v wanted %v", reports[0].Object, "Namespace/operator-system")
+	}
+	if reports[1].Object != "ServiceAccount/controller-manager.operator-system" {
+		t.Errorf("Got object %v wanted %v", reports[1].Object, "ServiceAccount/controller-manager.operator-system")
+	}
+}
+
+func TestDetectLineBreak(t *testing.T) {
+	if lineBreak := detectLineBreak([]byte("a: 1\r\n---\r\nb: 2\r\n")); lineBreak != "\r\n" {
+		t.Errorf("Got line break %q wanted %q", lineBreak, "\r\n")
+	}
+	if lineBreak := detectLineBreak([]byte("a: 1\n---\nb: 2\n")); lineBreak != "\n" {
+		t.Errorf("Got line break %q wanted %q", lineBreak, "\n")
+	}
+}
+
+func TestRuleset_Concurrency(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: kube-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+          runAsUser: 0
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	parallel := rs.generateReport("operator.yaml", json, schemaDir)
+
+	rs.Concurrency = 1
+	serial := rs.generateReport("operator.yaml", json, schemaDir)
+
+	if serial.Score != parallel.Score {
+		t.Errorf("Got score %v wanted %v", serial.Score, parallel.Score)
+	}
+	if !reflect.DeepEqual(serial.Scoring, parallel.Scoring) {
+		t.Errorf("Got scoring %v wanted %v", serial.Scoring, parallel.Scoring)
+	}
+}
+
+func benchmarkGenerateReport(b *testing.B, concurrency int) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: controller:latest
+        securityContext:
+          allowPrivilegeEscalation: false
+          runAsNonRoot: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		b.Fatal(err.Error())
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+
+	// a large synthetic ruleset of copies of the default rules
+	rules := make([]Rule, 0)
+	for i := 0; i < 20; i++ {
+		for _, rule := range rs.Rules {
+			rule.ID = fmt.Sprintf("%s-%d", rule.ID, i)
+			rules = append(rules, rule)
+		}
+	}
+	rs.Rules = rules
+
+	if concurrency < 1 {
+		// one slot per rule matches launching every rule in its own goroutine at once
+		concurrency = len(rs.Rules)
+	}
+	rs.Concurrency = concurrency
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		rs.generateReport("operator.yaml", json, schemaDir)
+	}
+}
+
+func BenchmarkRuleset_GenerateReport_Unbounded(b *testing.B) {
+	benchmarkGenerateReport(b, 0)
+}
+
+func BenchmarkRuleset_GenerateReport_Pool(b *testing.B) {
+	benchmarkGenerateReport(b, runtime.NumCPU())
+}
+
+func TestRuleset_RunContext_Cancelled(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	reports, err := NewRuleset(zap.NewNop().Sugar()).RunContext(ctx, "operator.yaml", []byte(data), schemaDir)
+	if err != context.Canceled {
+		t.Errorf("Got error %v wanted %v", err, context.Canceled)
+	}
+	if reports != nil {
+		t.Errorf("Got %v reports wanted none", len(reports))
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Got elapsed time %v wanted a prompt return", elapsed)
+	}
+}
+
+func TestRuleset_RunContext_Deadline(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	rs.Concurrency = 1
+	rs.Rules = append(rs.Rules, Rule{
+		ID:    "Slow",
+		Kinds: []string{"Namespace"},
+		Predicate: func(json []byte) int {
+			time.Sleep(50 * time.Millisecond)
+			return 0
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := rs.RunContext(ctx, "operator.yaml", []byte(data), schemaDir)
+	if err != context.DeadlineExceeded {
+		t.Errorf("Got error %v wanted %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestNewRuleset_Registry(t *testing.T) {
+	rs := NewRuleset(zap.NewNop().Sugar())
+
+	for _, rule := range rs.Rules {
+		registered, ok := rules.Lookup(rule.ID)
+		if !ok {
+			t.Errorf("Got no registered predicate for rule %v", rule.ID)
+			continue
+		}
+		if reflect.ValueOf(registered).Pointer() != reflect.ValueOf(rule.Predicate).Pointer() {
+			t.Errorf("Got a different predicate for rule %v than the registered one", rule.ID)
+		}
+	}
+}
+
+func TestNewRuleset_ParsedPredicate(t *testing.T) {
+	for _, rule := range NewRuleset(zap.NewNop().Sugar()).Rules {
+		if _, ok := rules.LookupParsed(rule.ID); ok && rule.ParsedPredicate == nil {
+			t.Errorf("Got no parsed predicate for rule %v", rule.ID)
+		}
+	}
+}
+
+func TestNewRuleset_Remediation(t *testing.T) {
+	rs := NewRuleset(zap.NewNop().Sugar())
+
+	for _, rule := range rs.Rules {
+		if rule.Remediation == "" {
+			t.Errorf("Got no remediation for rule %v", rule.ID)
+		}
+	}
+	for _, rule := range rs.AggregateRules {
+		if rule.Remediation == "" {
+			t.Errorf("Got no remediation for aggregate rule %v", rule.ID)
+		}
+	}
+}
+
+func TestRuleset_Run_Remediation(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	out, err := json.Marshal(reports[0].Scoring)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	want := `"remediation":"Remove securityContext.privileged: true, or set it to false, on every container"`
+	if !strings.Contains(string(out), want) {
+		t.Errorf("Got %s wanted it to contain %v", out, want)
+	}
+}
+
+func TestNewRuleset_Link(t *testing.T) {
+	rs := NewRuleset(zap.NewNop().Sugar())
+
+	links := make(map[string]string)
+	for _, rule := range rs.Rules {
+		links[rule.ID] = rule.Link
+	}
+	for _, rule := range rs.AggregateRules {
+		links[rule.ID] = rule.Link
+	}
+
+	for id, link := range links {
+		u, err := url.ParseRequestURI(link)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			t.Errorf("Got link %q for rule %v wanted an https URL", link, id)
+		}
+	}
+}
+
+func TestRuleset_Run_Link(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	reports, err := rs.Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, ruleRef := range reports[0].Scoring.Critical {
+		if ruleRef.ID != "Privileged" {
+			continue
+		}
+
+		out, err := json.Marshal(ruleRef)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		want := `"href":"https://kubernetes.io/docs/concepts/security/pod-security-standards/"`
+		if !strings.Contains(string(out), want) {
+			t.Errorf("Got %s wanted it to contain %v", out, want)
+		}
+		return
+	}
+	t.Errorf("Privileged rule did not match")
+}
+
+func TestGetObjectName(t *testing.T) {
+	tests := map[string]string{
+		"ClusterRole/example-operator": `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+`,
+		"Namespace/operator-system": `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+`,
+		"Deployment/controller-manager.operator-system": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`,
+		"Deployment/controller-manager.default": `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+`,
+	}
+
+	for want, data := range tests {
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if object := getObjectName(json); object != want {
+			t.Errorf("Got object %v wanted %v", object, want)
+		}
+	}
+}
+
+func TestRuleset_PrivilegedInitContainerReason(t *testing.T) {
+	tests := map[string]string{
+		`
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    securityContext:
+      privileged: true
+  containers:
+  - name: manager
+`: "Operators should not deploy with privileged: true (matched in init containers)",
+		`
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    securityContext:
+      privileged: true
+`: "Operators should not deploy with privileged: true",
+		`
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    securityContext:
+      privileged: true
+  containers:
+  - name: manager
+    securityContext:
+      privileged: true
+`: "Operators should not deploy with privileged: true (1 of 2 matches in init containers)",
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	for data, want := range tests {
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		report := rs.generateReport("operator.yaml", json, schemaDir)
+
+		var found bool
+		for _, ruleRef := range report.Scoring.Critical {
+			if ruleRef.ID != "Privileged" {
+				continue
+			}
+			found = true
+			if ruleRef.Reason != want {
+				t.Errorf("Got reason %q wanted %q", ruleRef.Reason, want)
+			}
+		}
+		if !found {
+			t.Errorf("Got no Privileged match wanted one")
+		}
+	}
+}
+
+func TestRuleset_ScalePoints(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    securityContext:
+      capabilities:
+        add: ["NET_ADMIN", "SYS_PTRACE", "SYS_MODULE"]
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := NewRuleset(zap.NewNop().Sugar()).generateReport("operator.yaml", json, schemaDir)
+
+	var found bool
+	for _, ruleRef := range report.Scoring.Critical {
+		if ruleRef.ID != "DangerousCapabilities" {
+			continue
+		}
+		found = true
+		if ruleRef.Points != -12 {
+			t.Errorf("Got %v points wanted %v", ruleRef.Points, -12)
+		}
+		if ruleRef.Severity != severityFor(-4) {
+			t.Errorf("Got severity %v wanted %v", ruleRef.Severity, severityFor(-4))
+		}
+	}
+	if !found {
+		t.Errorf("Got no DangerousCapabilities match wanted one")
+	}
+}
+
+func TestRuleset_UseExplicitReadOnlyRootFilesystem(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+  containers:
+  - name: manager
+    securityContext:
+      readOnlyRootFilesystem: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := func(rs *Ruleset) int {
+		report := rs.generateReport("operator.yaml", json, schemaDir)
+		for _, ruleRef := range report.Rules {
+			if ruleRef.ID == "ReadOnlyRootFilesystem" {
+				return ruleRef.Containers
+			}
+		}
+		t.Fatalf("Got no ReadOnlyRootFilesystem rule wanted one")
+		return 0
+	}
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	if matched := containers(rs); matched != 1 {
+		t.Errorf("Got %v containers wanted %v", matched, 1)
+	}
+
+	rs.UseExplicitReadOnlyRootFilesystem()
+	if matched := containers(rs); matched != 0 {
+		t.Errorf("Got %v containers wanted %v", matched, 0)
+	}
+}
+
+func TestRuleset_IgnoreAnnotation(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+%s
+spec:
+  containers:
+  - name: manager
+    ports:
+    - containerPort: 8443
+      hostPort: 8443
+`
+
+	report := func(annotations string) Report {
+		json, err := yaml.YAMLToJSON([]byte(fmt.Sprintf(data, annotations)))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return NewRuleset(zap.NewNop().Sugar()).generateReport("operator.yaml", json, schemaDir)
+	}
+
+	containsRule := func(ruleRefs []RuleRef, id string) bool {
+		for _, ruleRef := range ruleRefs {
+			if ruleRef.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+
+	before := report("")
+	after := report(`  annotations:
+    badrobot.controlplane.io/ignore: "HostPort, UnknownRule"`)
+
+	if !containsRule(before.Scoring.Critical, "HostPort") {
+		t.Errorf("Got no critical HostPort wanted one without the annotation")
+	}
+	if containsRule(after.Scoring.Critical, "HostPort") {
+		t.Errorf("Got a critical HostPort wanted it ignored")
+	}
+	if !containsRule(after.Scoring.Ignored, "HostPort") {
+		t.Errorf("Got no ignored HostPort wanted one")
+	}
+	if len(after.Scoring.Ignored) != 1 {
+		t.Errorf("Got %v ignored rules wanted %v", len(after.Scoring.Ignored), 1)
+	}
+	if after.Score-before.Score != 4 {
+		t.Errorf("Got score %v wanted %v", after.Score, before.Score+4)
+	}
+}
+
+func TestRuleset_HostNetworkDaemonSet(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: %s
+metadata:
+  name: agent
+  namespace: operator-system
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+      - name: agent
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	score := func(kind string) int {
+		json, err := yaml.YAMLToJSON([]byte(fmt.Sprintf(data, kind)))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return rs.generateReport("operator.yaml", json, schemaDir).Score
+	}
+
+	daemonSet, deployment := score("DaemonSet"), score("Deployment")
+	if daemonSet >= deployment {
+		t.Errorf("Got DaemonSet score %v wanted lower than Deployment score %v", daemonSet, deployment)
+	}
+}
+
+func TestRuleset_NodeProxyRead(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operator
+rules:
+- apiGroups: [""]
+  resources: [%s]
+  verbs: [get, list]
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	score := func(resource string) int {
+		json, err := yaml.YAMLToJSON([]byte(fmt.Sprintf(data, resource)))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return rs.generateReport("operator.yaml", json, schemaDir).Score
+	}
+
+	proxy, nodes := score("nodes/proxy"), score("nodes")
+	if proxy >= nodes {
+		t.Errorf("Got nodes/proxy score %v wanted lower than nodes score %v", proxy, nodes)
+	}
+}
+
+func TestRuleset_InternalRegistryDigest(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  containers:
+  - name: manager
+    image: %s
+`
+
+	rs := NewRuleset(zap.NewNop().Sugar())
+	rs.UseInternalRegistries("registry.example.com")
+	scoring := func(image string) RuleScoring {
+		json, err := yaml.YAMLToJSON([]byte(fmt.Sprintf(data, image)))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		return rs.generateReport("operator.yaml", json, schemaDir).Scoring
+	}
+	has := func(refs []RuleRef) bool {
+		for _, ref := range refs {
+			if ref.ID == "InternalRegistryDigest" {
+				return true
+			}
+		}
+		return false
+	}
+
+	pinned := scoring("registry.example.com/operator@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e")
+	if !has(pinned.Passed) {
+		t.Errorf("Got passed rules %v wanted InternalRegistryDigest", pinned.Passed)
+	}
+
+	mutable := scoring("registry.example.com/operator:v1.0.0")
+	if !has(mutable.Advise) {
+		t.Errorf("Got advise rules %v wanted InternalRegistryDigest", mutable.Advise)
+	}
+
+	external := scoring("ghcr.io/example/operator:v1.0.0")
+	if has(external.Passed) || has(external.Advise) {
+		t.Errorf("Got InternalRegistryDigest scored for a pod without internal images")
+	}
+}
+
+func TestRuleset_MemoryEmptyDirSizeLimit_NoAdvice(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operator
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+      volumes:
+      - name: scratch
+        emptyDir: {}
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	report := NewRuleset(zap.NewNop().Sugar()).generateReport("operator.yaml", json, schemaDir)
+	for _, ref := range append(report.Scoring.Advise, report.Scoring.Passed...) {
+		if ref.ID == "MemoryEmptyDirSizeLimit" {
+			t.Errorf("Got MemoryEmptyDirSizeLimit scored for a pod without a memory-backed emptyDir")
+		}
+	}
+}
+
+func TestNewRulesetWithLogger_StructuredFields(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	core, logs := observer.New(zapcore.DebugLevel)
+	_, err := NewRulesetWithLogger(zap.New(core)).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	entries := logs.FilterMessage("negative score rule matched").FilterField(zap.String("rule_id", "Privileged")).All()
+	if len(entries) != 1 {
+		t.Fatalf("Got %v log entries wanted %v", len(entries), 1)
+	}
+
+	fields := entries[0].ContextMap()
+	if fields["object"] != "Pod/operator.operator-system" {
+		t.Errorf("Got object %v wanted %v", fields["object"], "Pod/operator.operator-system")
+	}
+	for _, key := range []string{"points", "score"} {
+		if _, ok := fields[key]; !ok {
+			t.Errorf("Got fields %v wanted %v", fields, key)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package ruler
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// manifestExtensions are the file extensions ScanPath reads as manifests
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// ScanPathError collects the files ScanPath could not read or scan
+type ScanPathError struct {
+	Errors []error
+}
+
+func (e *ScanPathError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		messages = append(messages, err.Error())
+	}
+	return fmt.Sprintf("%d files could not be scanned: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// ScanPath walks root and runs the ruleset over every YAML or JSON file, returning the
+// reports keyed by path relative to root. Other files are skipped. Files that cannot
+// be read or parsed do not stop the walk, they are returned together in a *ScanPathError.
+func (rs *Ruleset) ScanPath(root string, schemaDir string) (map[string][]Report, error) {
+	results := make(map[string][]Report)
+	scanErr := &ScanPathError{}
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			scanErr.Errors = append(scanErr.Errors, err)
+			if info != nil && info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.IsDir() || !containsString(manifestExtensions, strings.ToLower(filepath.Ext(path))) {
+			return nil
+		}
+
+		fileName, err := filepath.Rel(root, path)
+		if err != nil || fileName == "." {
+			fileName = filepath.Base(path)
+		}
+
+		fileBytes, err := ioutil.ReadFile(path)
+		if err != nil {
+			scanErr.Errors = append(scanErr.Errors, err)
+			return nil
+		}
+
+		reports, err := rs.Run(fileName, fileBytes, schemaDir)
+		if err != nil {
+			rs.logger.Debugf("skipping %v: %v", fileName, err)
+			scanErr.Errors = append(scanErr.Errors, fmt.Errorf("%s: %w", fileName, err))
+			return nil
+		}
+		results[fileName] = reports
+
+		return nil
+	})
+	if err != nil {
+		return results, err
+	}
+
+	if len(scanErr.Errors) > 0 {
+		return results, scanErr
+	}
+
+	return results, nil
+}
@@ -0,0 +1,83 @@
+package ruler
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestRuleset_ScanPath(t *testing.T) {
+	root := t.TempDir()
+
+	files := map[string]string{
+		"operator.yaml": `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: operator-system
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`,
+		"rbac/role.json": `{"apiVersion": "rbac.authorization.k8s.io/v1", "kind": "ClusterRole", "metadata": {"name": "example-operator"}}`,
+		"broken.yml":     "kind: [Namespace\n",
+		"README.md":      "# operator manifests\n",
+	}
+
+	for name, data := range files {
+		path := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err.Error())
+		}
+		if err := ioutil.WriteFile(path, []byte(data), 0644); err != nil {
+			t.Fatal(err.Error())
+		}
+	}
+
+	results, err := NewRuleset(zap.NewNop().Sugar()).ScanPath(root, schemaDir)
+
+	scanErr, ok := err.(*ScanPathError)
+	if !ok {
+		t.Fatalf("Got error %v wanted a *ScanPathError", err)
+	}
+	if len(scanErr.Errors) != 1 {
+		t.Errorf("Got %v errors wanted %v", len(scanErr.Errors), 1)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Got %v files wanted %v", len(results), 2)
+	}
+	if reports := results["operator.yaml"]; len(reports) != 2 {
+		t.Errorf("Got %v reports wanted %v", len(reports), 2)
+	}
+	if reports := results[filepath.Join("rbac", "role.json")]; len(reports) != 1 || reports[0].FileName != filepath.Join("rbac", "role.json") {
+		t.Errorf("Got reports %v wanted one for %v", reports, filepath.Join("rbac", "role.json"))
+	}
+	if _, ok := results["README.md"]; ok {
+		t.Errorf("Got reports for README.md wanted it skipped")
+	}
+}
+
+func TestRuleset_ScanPath_NoErrors(t *testing.T) {
+	root := t.TempDir()
+
+	err := ioutil.WriteFile(filepath.Join(root, "namespace.yaml"), []byte("apiVersion: v1\nkind: Namespace\nmetadata:\n  name: operator-system\n"), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	results, err := NewRuleset(zap.NewNop().Sugar()).ScanPath(root, schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if len(results) != 1 {
+		t.Errorf("Got %v files wanted %v", len(results), 1)
+	}
+}
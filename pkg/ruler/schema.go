@@ -0,0 +1,85 @@
+package ruler
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// compiled schemas by path, shared across documents and rulesets
+var schemaCache sync.Map
+
+// schemaFileName returns the file name of the schema for a kind in the layout of
+// kubernetes-json-schema, e.g. deployment-apps-v1.json or clusterrole-rbac-v1.json
+func schemaFileName(apiVersion string, kind string) string {
+	group, version := "", apiVersion
+	if i := strings.Index(apiVersion, "/"); i >= 0 {
+		group, version = apiVersion[:i], apiVersion[i+1:]
+	}
+
+	name := strings.ToLower(kind)
+	if group != "" {
+		name += "-" + strings.ToLower(strings.Split(group, ".")[0])
+	}
+
+	return name + "-" + strings.ToLower(version) + ".json"
+}
+
+func loadSchema(path string) (*gojsonschema.Schema, error) {
+	if schema, ok := schemaCache.Load(path); ok {
+		return schema.(*gojsonschema.Schema), nil
+	}
+
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewReferenceLoader("file://" + filepath.ToSlash(path)))
+	if err != nil {
+		return nil, err
+	}
+	schemaCache.Store(path, schema)
+
+	return schema, nil
+}
+
+// validateSchema validates a document against the schema for its kind in schemaDir.
+// It returns a message describing why the document is invalid, or "" when it is valid.
+func validateSchema(data []byte, schemaDir string) string {
+	typeMeta := &struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}{}
+	if err := json.Unmarshal(data, typeMeta); err != nil || typeMeta.Kind == "" || typeMeta.APIVersion == "" {
+		return "This resource is invalid, Kubernetes kind not found"
+	}
+
+	path, err := filepath.Abs(filepath.Join(schemaDir, schemaFileName(typeMeta.APIVersion, typeMeta.Kind)))
+	if err != nil {
+		return err.Error()
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return "This resource is invalid, unknown schema"
+	}
+
+	schema, err := loadSchema(path)
+	if err != nil {
+		return fmt.Sprintf("This resource could not be validated: %v", err)
+	}
+
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return err.Error()
+	}
+
+	if !result.Valid() {
+		descriptions := make([]string, 0, len(result.Errors()))
+		for _, desc := range result.Errors() {
+			descriptions = append(descriptions, desc.String())
+		}
+		return strings.Join(descriptions, " ")
+	}
+
+	return ""
+}
@@ -0,0 +1,134 @@
+package ruler
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+var deploymentSchema = `{
+  "type": "object",
+  "required": ["apiVersion", "kind", "metadata", "spec"],
+  "properties": {
+    "apiVersion": {"type": "string"},
+    "kind": {"type": "string"},
+    "metadata": {"type": "object"},
+    "spec": {
+      "type": "object",
+      "required": ["template"],
+      "properties": {
+        "replicas": {"type": "integer"},
+        "template": {"type": "object"}
+      }
+    }
+  }
+}`
+
+func writeSchemaDir(t *testing.T) string {
+	dir := t.TempDir()
+	err := ioutil.WriteFile(filepath.Join(dir, "deployment-apps-v1.json"), []byte(deploymentSchema), 0644)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return dir
+}
+
+func TestSchemaFileName(t *testing.T) {
+	tests := map[string][]string{
+		"deployment-apps-v1.json":  {"apps/v1", "Deployment"},
+		"clusterrole-rbac-v1.json": {"rbac.authorization.k8s.io/v1", "ClusterRole"},
+		"namespace-v1.json":        {"v1", "Namespace"},
+	}
+
+	for want, typeMeta := range tests {
+		if got := schemaFileName(typeMeta[0], typeMeta[1]); got != want {
+			t.Errorf("Got schema file %v wanted %v", got, want)
+		}
+	}
+}
+
+func TestRuleset_Run_Schema(t *testing.T) {
+	dir := writeSchemaDir(t)
+
+	tests := []struct {
+		name  string
+		data  string
+		valid bool
+	}{
+		{
+			name: "valid deployment",
+			data: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: manager
+`,
+			valid: true,
+		},
+		{
+			name: "broken deployment",
+			data: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: operator-system
+spec:
+  replicas: one
+`,
+			valid: false,
+		},
+		{
+			name: "unknown kind",
+			data: `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+`,
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(test.data), dir)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		report := reports[0]
+		if report.Valid != test.valid {
+			t.Errorf("Got valid %v for %s wanted %v (%s)", report.Valid, test.name, test.valid, report.Message)
+		}
+		if !test.valid && (report.Message == "" || len(report.Rules) != 0) {
+			t.Errorf("Got message %q and %v rules for %s wanted a message and no rules", report.Message, len(report.Rules), test.name)
+		}
+	}
+}
+
+func TestRuleset_Run_NoSchemaDir(t *testing.T) {
+	var data = `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: widget
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !reports[0].Valid {
+		t.Errorf("Got valid %v wanted %v", reports[0].Valid, true)
+	}
+}
@@ -0,0 +1,54 @@
+package ruler
+
+// Rule categories group rules by the area of the Operator they audit
+const (
+	CategoryNamespace   = "Namespace"
+	CategoryPodSecurity = "PodSecurity"
+	CategoryRBAC        = "RBAC"
+	CategoryCorrectness = "Correctness"
+	CategoryAdmission   = "Admission"
+)
+
+// Severity is a triage label derived from the points of a rule
+type Severity string
+
+const (
+	SeverityInfo     Severity = "Info"
+	SeverityMedium   Severity = "Medium"
+	SeverityHigh     Severity = "High"
+	SeverityCritical Severity = "Critical"
+)
+
+// Points at or below which a rule is given the severity. Other rules with negative points
+// are Medium, and rules with zero or positive points are Info.
+var (
+	CriticalPoints = -30
+	HighPoints     = -9
+)
+
+func severityFor(points int) Severity {
+	switch {
+	case points <= CriticalPoints:
+		return SeverityCritical
+	case points <= HighPoints:
+		return SeverityHigh
+	case points < 0:
+		return SeverityMedium
+	default:
+		return SeverityInfo
+	}
+}
+
+// rank orders severities from Info (0) to Critical (3), unknown severities rank as Info
+func (s Severity) rank() int {
+	switch s {
+	case SeverityMedium:
+		return 1
+	case SeverityHigh:
+		return 2
+	case SeverityCritical:
+		return 3
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,82 @@
+package ruler
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+)
+
+func TestSeverityFor(t *testing.T) {
+	tests := []struct {
+		points   int
+		severity Severity
+	}{
+		{-31, SeverityCritical},
+		{-30, SeverityCritical},
+		{-29, SeverityHigh},
+		{-16, SeverityHigh},
+		{-9, SeverityHigh},
+		{-8, SeverityMedium},
+		{-1, SeverityMedium},
+		{0, SeverityInfo},
+		{3, SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		if severity := severityFor(tt.points); severity != tt.severity {
+			t.Errorf("Got severity %v for %v points wanted %v", severity, tt.points, tt.severity)
+		}
+	}
+}
+
+func TestSeverityFor_ConfiguredThresholds(t *testing.T) {
+	previous := CriticalPoints
+	CriticalPoints = -16
+	defer func() { CriticalPoints = previous }()
+
+	if severity := severityFor(-15); severity != SeverityHigh {
+		t.Errorf("Got severity %v wanted %v", severity, SeverityHigh)
+	}
+	if severity := severityFor(-16); severity != SeverityCritical {
+		t.Errorf("Got severity %v wanted %v", severity, SeverityCritical)
+	}
+}
+
+func TestRuleset_Run_SeverityInJSON(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+  namespace: operator-system
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      privileged: true
+`
+
+	reports, err := NewRuleset(zap.NewNop().Sugar()).Run("operator.yaml", []byte(data), schemaDir)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	out, err := json.Marshal(reports)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, ruleRef := range reports[0].Scoring.Critical {
+		if ruleRef.ID == "Privileged" {
+			want := `"severity":"` + string(severityFor(ruleRef.Points)) + `"`
+			if !strings.Contains(string(out), want) {
+				t.Errorf("Got %s wanted it to contain %v", out, want)
+			}
+			return
+		}
+	}
+	t.Errorf("Privileged rule did not match")
+}
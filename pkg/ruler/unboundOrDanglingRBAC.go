@@ -0,0 +1,88 @@
+// OPR-R74-BUNDLE - role is never bound or binding references a role not in the bundle
+package ruler
+
+import (
+	"encoding/json"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// built-in ClusterRoles a bundle may bind to without defining them
+var builtinClusterRoles = []string{"cluster-admin", "admin", "edit", "view"}
+
+func UnboundOrDanglingRBAC(reports []Report, docs [][]byte) int {
+	clusterRoles := make(map[string]bool)
+	roles := make(map[string]bool)
+	bound := make(map[string]bool)
+	bindings := make([]*rbacv1.RoleBinding, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		switch object.Kind {
+		case "ClusterRole":
+			// aggregated ClusterRoles are bound through the role they aggregate into
+			if !isAggregatedClusterRole(object.Metadata.Labels) {
+				clusterRoles[object.Metadata.Name] = true
+			}
+		case "Role":
+			roles[object.Metadata.Namespace+"/"+object.Metadata.Name] = true
+		case "ClusterRoleBinding", "RoleBinding":
+			binding := &rbacv1.RoleBinding{}
+			if json.Unmarshal(doc, binding) == nil {
+				bindings = append(bindings, binding)
+			}
+		}
+	}
+
+	rbac := 0
+	for _, binding := range bindings {
+		switch binding.RoleRef.Kind {
+		case "ClusterRole":
+			bound["ClusterRole/"+binding.RoleRef.Name] = true
+			if !clusterRoles[binding.RoleRef.Name] && !isBuiltinClusterRole(binding.RoleRef.Name) {
+				rbac++
+			}
+		case "Role":
+			key := binding.Namespace + "/" + binding.RoleRef.Name
+			bound["Role/"+key] = true
+			if !roles[key] {
+				rbac++
+			}
+		}
+	}
+
+	for name := range clusterRoles {
+		if !bound["ClusterRole/"+name] {
+			rbac++
+		}
+	}
+	for key := range roles {
+		if !bound["Role/"+key] {
+			rbac++
+		}
+	}
+
+	return rbac
+}
+
+func isBuiltinClusterRole(name string) bool {
+	return strings.HasPrefix(name, "system:") || containsString(builtinClusterRoles, name)
+}
+
+func isAggregatedClusterRole(labels map[string]string) bool {
+	for label := range labels {
+		if strings.HasPrefix(label, "rbac.authorization.k8s.io/aggregate-to-") {
+			return true
+		}
+	}
+	return false
+}
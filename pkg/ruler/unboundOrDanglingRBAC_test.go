@@ -0,0 +1,101 @@
+package ruler
+
+import (
+	"testing"
+)
+
+const exampleClusterRole = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - example.com
+  resources:
+  - widgets
+  verbs:
+  - get
+`
+
+const exampleClusterRoleBinding = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+func Test_UnboundOrDanglingRBAC_Unbound(t *testing.T) {
+	var serviceAccount = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, docs := bundleDocs(t, exampleClusterRole, serviceAccount)
+
+	rbac := UnboundOrDanglingRBAC(reports, docs)
+	if rbac != 1 {
+		t.Errorf("Got %v objects wanted %v", rbac, 1)
+	}
+}
+
+func Test_UnboundOrDanglingRBAC_Dangling(t *testing.T) {
+	var roleBinding = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: leader-election
+  namespace: operator-system
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: leader-election
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, docs := bundleDocs(t, exampleClusterRoleBinding, roleBinding)
+
+	rbac := UnboundOrDanglingRBAC(reports, docs)
+	if rbac != 2 {
+		t.Errorf("Got %v objects wanted %v", rbac, 2)
+	}
+}
+
+func Test_UnboundOrDanglingRBAC_Paired(t *testing.T) {
+	var viewBinding = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: view
+  namespace: operator-system
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: view
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+	reports, docs := bundleDocs(t, exampleClusterRole, exampleClusterRoleBinding, viewBinding)
+
+	rbac := UnboundOrDanglingRBAC(reports, docs)
+	if rbac != 0 {
+		t.Errorf("Got %v objects wanted %v", rbac, 0)
+	}
+}
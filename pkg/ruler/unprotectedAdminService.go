@@ -0,0 +1,113 @@
+// OPR-R91-BUNDLE - Service exposes an admin or metrics port without a NetworkPolicy
+package ruler
+
+import (
+	"encoding/json"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SensitiveServicePorts are the admin, metrics and kubelet ports that should only be
+// reachable from the pods that need them
+var SensitiveServicePorts = []int32{8080, 8443, 9090, 9443, 10250, 10255}
+
+// SensitiveServicePortNames are substrings of port names that mark a port as sensitive
+var SensitiveServicePortNames = []string{"metrics", "admin", "debug", "pprof"}
+
+func UnprotectedAdminService(reports []Report, docs [][]byte) int {
+	services := make([]*bundleObject, 0)
+	policies := make([]*bundleObject, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		switch object.Kind {
+		case "Service":
+			services = append(services, object)
+		case "NetworkPolicy":
+			policies = append(policies, object)
+		}
+	}
+
+	unprotected := 0
+	for _, service := range services {
+		spec := &corev1.ServiceSpec{}
+		if json.Unmarshal(service.Spec, spec) != nil || len(spec.Selector) == 0 || !hasSensitivePort(spec.Ports) {
+			continue
+		}
+
+		if !restrictsIngress(policies, service.Metadata.Namespace, labels.Set(spec.Selector)) {
+			unprotected++
+		}
+	}
+
+	return unprotected
+}
+
+func hasSensitivePort(ports []corev1.ServicePort) bool {
+	for _, port := range ports {
+		if containsInt32(SensitiveServicePorts, port.Port) || containsInt32(SensitiveServicePorts, port.TargetPort.IntVal) {
+			return true
+		}
+		for _, name := range SensitiveServicePortNames {
+			if strings.Contains(strings.ToLower(port.Name), name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// restrictsIngress reports whether a NetworkPolicy in the namespace selects pods with
+// the given labels and restricts their ingress
+func restrictsIngress(policies []*bundleObject, namespace string, podLabels labels.Set) bool {
+	for _, policy := range policies {
+		if policy.Metadata.Namespace != namespace {
+			continue
+		}
+
+		spec := &networkingv1.NetworkPolicySpec{}
+		if json.Unmarshal(policy.Spec, spec) != nil {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&spec.PodSelector)
+		if err != nil || !selector.Matches(podLabels) {
+			continue
+		}
+
+		// a policy without policyTypes always applies to ingress
+		if len(spec.PolicyTypes) == 0 {
+			return true
+		}
+		for _, policyType := range spec.PolicyTypes {
+			if policyType == networkingv1.PolicyTypeIngress {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsInt32(haystack []int32, needle int32) bool {
+	for _, v := range haystack {
+		if v == needle {
+			return true
+		}
+	}
+
+	return false
+}
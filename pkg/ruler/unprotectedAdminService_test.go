@@ -0,0 +1,97 @@
+package ruler
+
+import (
+	"testing"
+)
+
+const metricsService = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: controller-manager-metrics-service
+  namespace: operator-system
+spec:
+  selector:
+    control-plane: controller-manager
+  ports:
+  - name: https
+    port: 8443
+    targetPort: https
+`
+
+func Test_UnprotectedAdminService_NoNetworkPolicy(t *testing.T) {
+	reports, docs := bundleDocs(t, metricsService)
+
+	services := UnprotectedAdminService(reports, docs)
+	if services != 1 {
+		t.Errorf("Got %v services wanted %v", services, 1)
+	}
+}
+
+func Test_UnprotectedAdminService_NetworkPolicy(t *testing.T) {
+	reports, docs := bundleDocs(t, metricsService, `
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: allow-metrics-traffic
+  namespace: operator-system
+spec:
+  podSelector:
+    matchLabels:
+      control-plane: controller-manager
+  policyTypes:
+  - Ingress
+  ingress:
+  - from:
+    - namespaceSelector:
+        matchLabels:
+          metrics: enabled
+`)
+
+	services := UnprotectedAdminService(reports, docs)
+	if services != 0 {
+		t.Errorf("Got %v services wanted %v", services, 0)
+	}
+}
+
+func Test_UnprotectedAdminService_OtherPods(t *testing.T) {
+	reports, docs := bundleDocs(t, metricsService, `
+apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: allow-webhook-traffic
+  namespace: operator-system
+spec:
+  podSelector:
+    matchLabels:
+      app: webhook
+  policyTypes:
+  - Ingress
+`)
+
+	services := UnprotectedAdminService(reports, docs)
+	if services != 1 {
+		t.Errorf("Got %v services wanted %v", services, 1)
+	}
+}
+
+func Test_UnprotectedAdminService_Benign(t *testing.T) {
+	reports, docs := bundleDocs(t, `
+apiVersion: v1
+kind: Service
+metadata:
+  name: webhook-service
+  namespace: operator-system
+spec:
+  selector:
+    control-plane: controller-manager
+  ports:
+  - port: 443
+    targetPort: 9000
+`)
+
+	services := UnprotectedAdminService(reports, docs)
+	if services != 0 {
+		t.Errorf("Got %v services wanted %v", services, 0)
+	}
+}
@@ -0,0 +1,46 @@
+// OPR-R36-BUNDLE - ClusterRole with full permissions over all resources is bound cluster-wide
+package ruler
+
+import (
+	"encoding/json"
+
+	"github.com/controlplaneio/badrobot/pkg/rules"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func WildcardRoleWildcardBinding(reports []Report, docs [][]byte) int {
+	wildcardRoles := make(map[string]bool)
+	bindings := make([]*rbacv1.ClusterRoleBinding, 0)
+
+	for i, doc := range docs {
+		if i < len(reports) && !reports[i].Valid {
+			continue
+		}
+
+		object, ok := parseBundleObject(doc)
+		if !ok {
+			continue
+		}
+
+		switch object.Kind {
+		case "ClusterRole":
+			if rules.StarAllClusterRole(doc) > 0 {
+				wildcardRoles[object.Metadata.Name] = true
+			}
+		case "ClusterRoleBinding":
+			binding := &rbacv1.ClusterRoleBinding{}
+			if json.Unmarshal(doc, binding) == nil {
+				bindings = append(bindings, binding)
+			}
+		}
+	}
+
+	rbac := 0
+	for _, binding := range bindings {
+		if binding.RoleRef.Kind == "ClusterRole" && wildcardRoles[binding.RoleRef.Name] {
+			rbac++
+		}
+	}
+
+	return rbac
+}
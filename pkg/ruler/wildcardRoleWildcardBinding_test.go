@@ -0,0 +1,61 @@
+package ruler
+
+import (
+	"testing"
+)
+
+const wildcardClusterRole = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - "*"
+  resources:
+  - "*"
+  verbs:
+  - "*"
+`
+
+const wildcardClusterRoleBinding = `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+`
+
+func Test_WildcardRoleWildcardBinding(t *testing.T) {
+	reports, docs := bundleDocs(t, wildcardClusterRole, wildcardClusterRoleBinding)
+
+	rbac := WildcardRoleWildcardBinding(reports, docs)
+	if rbac != 1 {
+		t.Errorf("Got %v permissions wanted %v", rbac, 1)
+	}
+}
+
+func Test_WildcardRoleWildcardBinding_Role_Only(t *testing.T) {
+	reports, docs := bundleDocs(t, wildcardClusterRole)
+
+	rbac := WildcardRoleWildcardBinding(reports, docs)
+	if rbac != 0 {
+		t.Errorf("Got %v permissions wanted %v", rbac, 0)
+	}
+}
+
+func Test_WildcardRoleWildcardBinding_Binding_Only(t *testing.T) {
+	reports, docs := bundleDocs(t, wildcardClusterRoleBinding)
+
+	rbac := WildcardRoleWildcardBinding(reports, docs)
+	if rbac != 0 {
+		t.Errorf("Got %v permissions wanted %v", rbac, 0)
+	}
+}
@@ -0,0 +1,29 @@
+// OPR-R34-SC - securityContext adds ALL Linux capabilities
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func AddAllCapabilities(input []byte) int {
+	return withPodSpec(input, addAllCapabilitiesPodSpec)
+}
+
+func addAllCapabilitiesPodSpec(podSpec *corev1.PodSpec) int {
+	sc := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext == nil || container.SecurityContext.Capabilities == nil {
+			continue
+		}
+
+		for _, capability := range container.SecurityContext.Capabilities.Add {
+			if capability == "ALL" {
+				sc++
+				break
+			}
+		}
+	}
+
+	return sc
+}
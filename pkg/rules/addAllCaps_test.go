@@ -0,0 +1,68 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_AddAllCapabilities(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          capabilities:
+            add:
+            - ALL
+      containers:
+      - name: manager
+        securityContext:
+          capabilities:
+            add:
+            - NET_BIND_SERVICE
+            - ALL
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := AddAllCapabilities(json)
+	if securityContext != 2 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 2)
+	}
+}
+
+func Test_AddAllCapabilities_Specific(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    securityContext:
+      capabilities:
+        add:
+        - NET_BIND_SERVICE
+        drop:
+        - ALL
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := AddAllCapabilities(json)
+	if securityContext != 0 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
+	}
+}
@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func AdmissionControllerClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, admissionControllerClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func admissionControllerClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("admissionregistration.k8s.io", rule.APIGroups) &&
 			containsAny([]string{"mutatingwebhookconfigurations", "validatingwebhookconfigurations"}, rule.Resources) &&
 			containsAny([]string{"*", "create", "patch", "update", "delete", "deletecollection"}, rule.Verbs) {
@@ -0,0 +1,32 @@
+// OPR-R66-RBAC - ClusterRole aggregates into the built-in view or edit roles
+package rules
+
+import (
+	"encoding/json"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// the labels the built-in view and edit ClusterRoles select their aggregated rules with
+var viewEditAggregationLabels = []string{
+	"rbac.authorization.k8s.io/aggregate-to-view",
+	"rbac.authorization.k8s.io/aggregate-to-edit",
+}
+
+func AggregatesIntoViewEdit(input []byte) int {
+	rbac := 0
+
+	clusterRole := &rbacv1.ClusterRole{}
+	err := json.Unmarshal(input, clusterRole)
+	if err != nil {
+		return 0
+	}
+
+	for _, label := range viewEditAggregationLabels {
+		if clusterRole.Labels[label] == "true" {
+			rbac++
+		}
+	}
+
+	return rbac
+}
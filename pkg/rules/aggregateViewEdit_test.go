@@ -0,0 +1,66 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_AggregatesIntoViewEdit_ViewEdit(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator-widget-editor
+  labels:
+    rbac.authorization.k8s.io/aggregate-to-view: "true"
+    rbac.authorization.k8s.io/aggregate-to-edit: "true"
+rules:
+- apiGroups:
+  - example.com
+  resources:
+  - widgets
+  verbs:
+  - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rbac := AggregatesIntoViewEdit(json)
+	if rbac != 2 {
+		t.Errorf("Got %v aggregations wanted %v", rbac, 2)
+	}
+}
+
+func Test_AggregatesIntoViewEdit_Custom(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator-widget-editor
+  labels:
+    example.com/aggregate-to-widget-admin: "true"
+rules:
+- apiGroups:
+  - example.com
+  resources:
+  - widgets
+  verbs:
+  - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rbac := AggregatesIntoViewEdit(json)
+	if rbac != 0 {
+		t.Errorf("Got %v aggregations wanted %v", rbac, 0)
+	}
+}
@@ -0,0 +1,37 @@
+// OPR-R89-RBAC - ClusterRole can bind the built-in aggregated roles
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// aggregatedRoleNames are the built-in ClusterRoles that other roles aggregate into,
+// and the roles aggregated into them
+var aggregatedRoleNames = []string{
+	"admin",
+	"edit",
+	"view",
+	"system:aggregate-to-admin",
+	"system:aggregate-to-edit",
+	"system:aggregate-to-view",
+}
+
+func BindAggregateRolesClusterRole(input []byte) int {
+	return withPolicyRules(input, bindAggregateRolesClusterRoleRules)
+}
+
+func bindAggregateRolesClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	// bind without resourceNames is reported by BindClusterRole
+	for _, rule := range policyRules {
+		if containsAny([]string{"*", "rbac.authorization.k8s.io"}, rule.APIGroups) &&
+			containsAny([]string{"*", "clusterroles"}, rule.Resources) &&
+			containsAny([]string{"*", "bind"}, rule.Verbs) &&
+			containsAny(aggregatedRoleNames, rule.ResourceNames) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
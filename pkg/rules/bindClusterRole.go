@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func BindClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, bindClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func bindClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("rbac.authorization.k8s.io", rule.APIGroups) &&
 			contains("clusterroles", rule.Resources) &&
 			contains("bind", rule.Verbs) {
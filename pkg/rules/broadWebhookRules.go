@@ -0,0 +1,32 @@
+// OPR-R42-WH - webhook intercepts all resources
+package rules
+
+import (
+	"encoding/json"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func BroadWebhookRules(input []byte) int {
+	webhookRules := 0
+
+	config := &struct {
+		Webhooks []struct {
+			Rules []admissionregistrationv1.RuleWithOperations `json:"rules"`
+		} `json:"webhooks"`
+	}{}
+	err := json.Unmarshal(input, config)
+	if err != nil {
+		return 0
+	}
+
+	for _, webhook := range config.Webhooks {
+		for _, rule := range webhook.Rules {
+			if contains("*", rule.APIGroups) && contains("*", rule.APIVersions) && containsAny([]string{"*", "*/*"}, rule.Resources) {
+				webhookRules++
+			}
+		}
+	}
+
+	return webhookRules
+}
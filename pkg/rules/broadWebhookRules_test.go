@@ -0,0 +1,79 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_BroadWebhookRules_Wildcard(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: example-operator-mutating-webhook
+webhooks:
+- name: mexample.example.com
+  rules:
+  - apiGroups:
+    - "*"
+    apiVersions:
+    - "*"
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - "*"
+  - apiGroups:
+    - apps
+    apiVersions:
+    - v1
+    operations:
+    - CREATE
+    resources:
+    - deployments
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhookRules := BroadWebhookRules(json)
+	if webhookRules != 1 {
+		t.Errorf("Got %v webhook rules wanted %v", webhookRules, 1)
+	}
+}
+
+func Test_BroadWebhookRules_Scoped(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: example-operator-validating-webhook
+webhooks:
+- name: vexample.example.com
+  rules:
+  - apiGroups:
+    - example.com
+    apiVersions:
+    - "*"
+    operations:
+    - CREATE
+    - UPDATE
+    resources:
+    - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhookRules := BroadWebhookRules(json)
+	if webhookRules != 0 {
+		t.Errorf("Got %v webhook rules wanted %v", webhookRules, 0)
+	}
+}
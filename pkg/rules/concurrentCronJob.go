@@ -0,0 +1,52 @@
+// OPR-R92-SC - CronJob allows concurrent runs of a privileged pod
+package rules
+
+import (
+	"encoding/json"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+// ConcurrentPrivilegedCronJob returns how many privileged or capability-heavy containers a
+// CronJob runs when its concurrencyPolicy allows concurrent runs
+func ConcurrentPrivilegedCronJob(input []byte) int {
+	containers := 0
+
+	cronJob := &batchv1.CronJob{}
+	err := json.Unmarshal(input, cronJob)
+	if err != nil {
+		return 0
+	}
+
+	// an unset concurrencyPolicy defaults to Allow
+	policy := cronJob.Spec.ConcurrencyPolicy
+	if policy != "" && policy != batchv1.AllowConcurrent {
+		return 0
+	}
+
+	podSpec := &cronJob.Spec.JobTemplate.Spec.Template.Spec
+	for _, container := range allContainers(podSpec) {
+		sc := container.SecurityContext
+		if sc == nil {
+			continue
+		}
+
+		if sc.Privileged != nil && *sc.Privileged {
+			containers++
+			continue
+		}
+
+		if sc.Capabilities != nil {
+			for _, capability := range sc.Capabilities.Add {
+				name := strings.TrimPrefix(strings.ToUpper(string(capability)), "CAP_")
+				if name == "ALL" || contains(name, DangerousCapabilityNames) {
+					containers++
+					break
+				}
+			}
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,107 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ConcurrentPrivilegedCronJob_AllowPrivileged(t *testing.T) {
+	var data = `
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: node-cleanup
+spec:
+  schedule: "*/5 * * * *"
+  concurrencyPolicy: Allow
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: cleanup
+            securityContext:
+              privileged: true
+          - name: network
+            securityContext:
+              capabilities:
+                add:
+                - NET_ADMIN
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ConcurrentPrivilegedCronJob(json)
+	if containers != 2 {
+		t.Errorf("Got %v containers wanted %v", containers, 2)
+	}
+}
+
+func Test_ConcurrentPrivilegedCronJob_ForbidPrivileged(t *testing.T) {
+	var data = `
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: node-cleanup
+spec:
+  schedule: "*/5 * * * *"
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: cleanup
+            securityContext:
+              privileged: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ConcurrentPrivilegedCronJob(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
+
+func Test_ConcurrentPrivilegedCronJob_AllowUnprivileged(t *testing.T) {
+	var data = `
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: report
+spec:
+  schedule: "0 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: report
+            securityContext:
+              privileged: false
+              capabilities:
+                drop:
+                - ALL
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ConcurrentPrivilegedCronJob(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
@@ -0,0 +1,44 @@
+// OPR-R93-SC - container command or args embed a credential
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"regexp"
+	"strings"
+)
+
+// CredentialArgPatterns are regular expressions matched case-insensitively against the
+// command and args of each container, joined by spaces. Values taken from environment
+// variables, such as --token=$(TOKEN), are not matched by the defaults.
+var CredentialArgPatterns = []string{
+	`--?[a-z0-9-]*(token|password|passwd|secret|api-?key)[= ]+[^$\s-]\S*`,
+	`--?[a-z0-9-]*(auth|key|credentials?)[= ]+[a-z0-9+/_-]{32,}={0,2}(\s|$)`,
+	`://[^/\s:@]+:[^/\s@$]+@`,
+}
+
+func CredentialInArgs(input []byte) int {
+	return withPodSpec(input, credentialInArgsPodSpec)
+}
+
+func credentialInArgsPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	patterns := make([]*regexp.Regexp, 0, len(CredentialArgPatterns))
+	for _, pattern := range CredentialArgPatterns {
+		if re, err := regexp.Compile("(?i)" + pattern); err == nil {
+			patterns = append(patterns, re)
+		}
+	}
+
+	for _, container := range allContainers(podSpec) {
+		line := strings.Join(append(append([]string{}, container.Command...), container.Args...), " ")
+		for _, re := range patterns {
+			if re.MatchString(line) {
+				containers++
+				break
+			}
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_CredentialInArgs_Credential(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operator
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: migrate
+        command:
+        - /migrate
+        - --database=postgres://operator:hunter2@db:5432/operator
+      containers:
+      - name: manager
+        args:
+        - --leader-elect
+        - --api-token
+        - ghp_0123456789abcdef
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := CredentialInArgs(json)
+	if containers != 2 {
+		t.Errorf("Got %v containers wanted %v", containers, 2)
+	}
+}
+
+func Test_CredentialInArgs_Benign(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  containers:
+  - name: manager
+    args:
+    - --leader-elect
+    - --token-file=/var/run/secrets/operator/token
+    - --password=$(DB_PASSWORD)
+    - --secret-name=operator-credentials
+    - --metrics-bind-address=https://0.0.0.0:8443
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := CredentialInArgs(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
+
+func Test_CredentialInArgs_Patterns(t *testing.T) {
+	previous := CredentialArgPatterns
+	CredentialArgPatterns = []string{`--license=\S+`}
+	defer func() { CredentialArgPatterns = previous }()
+
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  containers:
+  - name: manager
+    args:
+    - --license=ABCD-1234
+    - --password=hunter2
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := CredentialInArgs(json)
+	if containers != 1 {
+		t.Errorf("Got %v containers wanted %v", containers, 1)
+	}
+}
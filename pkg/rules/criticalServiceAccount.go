@@ -0,0 +1,29 @@
+// OPR-R38-RBAC - ServiceAccount annotated as critical automounts its token
+package rules
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// CriticalServiceAccountAnnotation marks a ServiceAccount as highly privileged when set to "true"
+var CriticalServiceAccountAnnotation = "badrobot.controlplane.io/critical"
+
+func CriticalServiceAccount(input []byte) int {
+	serviceAccount := &corev1.ServiceAccount{}
+	err := json.Unmarshal(input, serviceAccount)
+	if err != nil {
+		return 0
+	}
+
+	if serviceAccount.Annotations[CriticalServiceAccountAnnotation] != "true" {
+		return 0
+	}
+
+	if serviceAccount.AutomountServiceAccountToken != nil && !*serviceAccount.AutomountServiceAccountToken {
+		return 0
+	}
+
+	return 1
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_CriticalServiceAccount_Annotated(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  annotations:
+    badrobot.controlplane.io/critical: "true"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sa := CriticalServiceAccount(json)
+	if sa != 1 {
+		t.Errorf("Got %v service accounts wanted %v", sa, 1)
+	}
+}
+
+func Test_CriticalServiceAccount_Annotated_Automount_Disabled(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  annotations:
+    badrobot.controlplane.io/critical: "true"
+automountServiceAccountToken: false
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sa := CriticalServiceAccount(json)
+	if sa != 0 {
+		t.Errorf("Got %v service accounts wanted %v", sa, 0)
+	}
+}
+
+func Test_CriticalServiceAccount_Unannotated(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+automountServiceAccountToken: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sa := CriticalServiceAccount(json)
+	if sa != 0 {
+		t.Errorf("Got %v service accounts wanted %v", sa, 0)
+	}
+}
+
+func Test_CriticalServiceAccount_Custom_Annotation(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: controller-manager
+  annotations:
+    example.com/high-privilege: "true"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	annotation := CriticalServiceAccountAnnotation
+	CriticalServiceAccountAnnotation = "example.com/high-privilege"
+	defer func() { CriticalServiceAccountAnnotation = annotation }()
+
+	sa := CriticalServiceAccount(json)
+	if sa != 1 {
+		t.Errorf("Got %v service accounts wanted %v", sa, 1)
+	}
+}
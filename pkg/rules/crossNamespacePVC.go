@@ -0,0 +1,33 @@
+// OPR-R54-RBAC - ClusterRole has access to persistent volume claims in all namespaces
+package rules
+
+import (
+	"encoding/json"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func CrossNamespacePVCClusterRole(input []byte) int {
+	rbac := 0
+
+	clusterRole := &rbacv1.ClusterRole{}
+	err := json.Unmarshal(input, clusterRole)
+	if err != nil {
+		return 0
+	}
+
+	// a Role is limited to the claims of its own namespace
+	if clusterRole.Kind != "ClusterRole" {
+		return 0
+	}
+
+	for _, rule := range clusterRole.Rules {
+		if contains("", rule.APIGroups) &&
+			contains("persistentvolumeclaims", rule.Resources) &&
+			containsAny([]string{"*", "get", "list", "create", "patch", "update", "delete", "deletecollection", "watch"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
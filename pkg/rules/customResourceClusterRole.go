@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func CustomResourceClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, customResourceClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func customResourceClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("apiextensions.k8s.io", rule.APIGroups) &&
 			contains("customresourcedefinitions", rule.Resources) &&
 			containsAny([]string{"*", "create", "patch", "update", "delete", "deletecollection"}, rule.Verbs) {
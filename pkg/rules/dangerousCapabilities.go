@@ -0,0 +1,37 @@
+// OPR-R81-SC - securityContext adds dangerous Linux capabilities
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"strings"
+)
+
+// DangerousCapabilityNames are the capabilities that must not be added to operator containers,
+// without the CAP_ prefix
+var DangerousCapabilityNames = []string{"SYS_ADMIN", "NET_ADMIN", "SYS_PTRACE", "SYS_MODULE", "DAC_OVERRIDE"}
+
+// DangerousCapabilities returns how many dangerous capabilities are added across all containers
+func DangerousCapabilities(input []byte) int {
+	return withPodSpec(input, dangerousCapabilitiesPodSpec)
+}
+
+func dangerousCapabilitiesPodSpec(podSpec *corev1.PodSpec) int {
+	capabilities := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext == nil || container.SecurityContext.Capabilities == nil {
+			continue
+		}
+
+		added := make(map[string]bool)
+		for _, capability := range container.SecurityContext.Capabilities.Add {
+			name := strings.TrimPrefix(strings.ToUpper(string(capability)), "CAP_")
+			if !added[name] && contains(name, DangerousCapabilityNames) {
+				added[name] = true
+				capabilities++
+			}
+		}
+	}
+
+	return capabilities
+}
@@ -0,0 +1,74 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_DangerousCapabilities(t *testing.T) {
+	tests := map[string]int{
+		"[NET_ADMIN]":               1,
+		"[SYS_PTRACE]":              1,
+		"[SYS_ADMIN]":               1,
+		"[CAP_SYS_MODULE]":          1,
+		"[NET_ADMIN, DAC_OVERRIDE]": 2,
+		"[NET_BIND_SERVICE]":        0,
+	}
+
+	for add, want := range tests {
+		var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          capabilities:
+            add: ` + add + `
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		capabilities := DangerousCapabilities(json)
+		if capabilities != want {
+			t.Errorf("Got %v capabilities for %v wanted %v", capabilities, add, want)
+		}
+	}
+}
+
+func Test_DangerousCapabilities_InitContainers(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    securityContext:
+      capabilities:
+        add: ["NET_ADMIN"]
+  containers:
+  - name: manager
+    securityContext:
+      capabilities:
+        add: ["SYS_PTRACE"]
+        drop: ["ALL"]
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	capabilities := DangerousCapabilities(json)
+	if capabilities != 2 {
+		t.Errorf("Got %v capabilities wanted %v", capabilities, 2)
+	}
+}
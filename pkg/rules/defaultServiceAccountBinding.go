@@ -0,0 +1,24 @@
+// OPR-R53-RBAC - role is bound to the default ServiceAccount
+package rules
+
+import (
+	"encoding/json"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func DefaultServiceAccountBinding(input []byte) int {
+	binding := &rbacv1.ClusterRoleBinding{}
+	err := json.Unmarshal(input, binding)
+	if err != nil {
+		return 0
+	}
+
+	for _, subject := range binding.Subjects {
+		if subject.Kind == rbacv1.ServiceAccountKind && subject.Name == "default" {
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,100 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_DefaultServiceAccountBinding_Default(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: example-operator
+  namespace: operator-system
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: default
+  namespace: operator-system
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rbac := DefaultServiceAccountBinding(json)
+	if rbac != 1 {
+		t.Errorf("Got %v bindings wanted %v", rbac, 1)
+	}
+}
+
+func Test_DefaultServiceAccountBinding_Named(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+- kind: User
+  name: default
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rbac := DefaultServiceAccountBinding(json)
+	if rbac != 0 {
+		t.Errorf("Got %v bindings wanted %v", rbac, 0)
+	}
+}
+
+func Test_DefaultServiceAccountBinding_Mixed(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRoleBinding
+metadata:
+  name: example-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: example-operator
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: operator-system
+- kind: ServiceAccount
+  name: default-operator
+  namespace: operator-system
+- kind: ServiceAccount
+  name: default
+  namespace: operator-system
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	rbac := DefaultServiceAccountBinding(json)
+	if rbac != 1 {
+		t.Errorf("Got %v bindings wanted %v", rbac, 1)
+	}
+}
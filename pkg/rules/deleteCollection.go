@@ -0,0 +1,38 @@
+// OPR-R70-RBAC - ClusterRole can delete collections of sensitive resources
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// deleteCollectionSensitiveResources are matched by name, roles granting * on every
+// resource or with every verb are flagged by the StarAll and CoreAPI rules instead
+var deleteCollectionSensitiveResources = []string{
+	"secrets",
+	"configmaps",
+	"pods",
+	"deployments",
+	"statefulsets",
+	"daemonsets",
+	"replicasets",
+	"jobs",
+	"cronjobs",
+}
+
+func DeleteCollectionSensitiveClusterRole(input []byte) int {
+	return withPolicyRules(input, deleteCollectionSensitiveClusterRoleRules)
+}
+
+func deleteCollectionSensitiveClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if containsAny([]string{"", "*", "apps", "batch"}, rule.APIGroups) &&
+			containsAny(deleteCollectionSensitiveResources, rule.Resources) &&
+			contains("deletecollection", rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
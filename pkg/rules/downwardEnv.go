@@ -0,0 +1,33 @@
+// OPR-R77-SC - env exposes node information through the downward API
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// nodeFieldPaths are the downward API fields that identify the node a pod runs on
+var nodeFieldPaths = []string{"spec.nodeName", "status.hostIP", "status.hostIPs"}
+
+func DownwardEnvNodeInfo(input []byte) int {
+	return withPodSpec(input, downwardEnvNodeInfoPodSpec)
+}
+
+func downwardEnvNodeInfoPodSpec(podSpec *corev1.PodSpec) int {
+	env := 0
+
+	for _, container := range allContainers(podSpec) {
+		for _, envVar := range container.Env {
+			if envVar.ValueFrom == nil || envVar.ValueFrom.FieldRef == nil {
+				continue
+			}
+			for _, path := range nodeFieldPaths {
+				if envVar.ValueFrom.FieldRef.FieldPath == path {
+					env++
+					break
+				}
+			}
+		}
+	}
+
+	return env
+}
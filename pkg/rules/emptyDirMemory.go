@@ -0,0 +1,31 @@
+// OPR-R78-SC - memory-backed emptyDir volumes set a sizeLimit
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MemoryEmptyDirSizeLimit returns 1 when the pod has memory-backed emptyDir volumes
+// and every one of them sets a sizeLimit. Pods without such volumes are not applicable.
+func MemoryEmptyDirSizeLimit(input []byte) int {
+	return withPodSpec(input, memoryEmptyDirSizeLimitPodSpec)
+}
+
+func memoryEmptyDirSizeLimitPodSpec(podSpec *corev1.PodSpec) int {
+	volumes := 0
+	for _, volume := range podSpec.Volumes {
+		if volume.EmptyDir == nil || volume.EmptyDir.Medium != corev1.StorageMediumMemory {
+			continue
+		}
+		if volume.EmptyDir.SizeLimit == nil || volume.EmptyDir.SizeLimit.IsZero() {
+			return 0
+		}
+		volumes++
+	}
+
+	if volumes > 0 {
+		return 1
+	}
+
+	return NotApplicable
+}
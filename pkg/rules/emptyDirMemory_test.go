@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_MemoryEmptyDirSizeLimit_SizeLimit(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+      volumes:
+      - name: cache
+        emptyDir:
+          medium: Memory
+          sizeLimit: 64Mi
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	volumes := MemoryEmptyDirSizeLimit(json)
+	if volumes != 1 {
+		t.Errorf("Got %v volumes wanted %v", volumes, 1)
+	}
+}
+
+func Test_MemoryEmptyDirSizeLimit_Missing(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+      volumes:
+      - name: cache
+        emptyDir:
+          medium: Memory
+          sizeLimit: 64Mi
+      - name: scratch
+        emptyDir:
+          medium: Memory
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	volumes := MemoryEmptyDirSizeLimit(json)
+	if volumes != 0 {
+		t.Errorf("Got %v volumes wanted %v", volumes, 0)
+	}
+}
+
+func Test_MemoryEmptyDirSizeLimit_DefaultMedium(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+      volumes:
+      - name: scratch
+        emptyDir: {}
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	volumes := MemoryEmptyDirSizeLimit(json)
+	if volumes != NotApplicable {
+		t.Errorf("Got %v volumes wanted %v", volumes, NotApplicable)
+	}
+}
@@ -0,0 +1,41 @@
+// OPR-R61-SC - workload declares more replicas than allowed
+package rules
+
+import (
+	"encoding/json"
+)
+
+// DefaultMaxReplicas is the largest replica count allowed for an operator workload
+// unless another limit is set with NewExcessiveReplicas
+const DefaultMaxReplicas int32 = 10
+
+func ExcessiveReplicas(input []byte) int {
+	return excessiveReplicas(input, DefaultMaxReplicas)
+}
+
+// NewExcessiveReplicas returns the ExcessiveReplicas predicates for the given replica limit
+func NewExcessiveReplicas(maxReplicas int32) Predicates {
+	return Predicates{
+		Predicate: func(input []byte) int {
+			return excessiveReplicas(input, maxReplicas)
+		},
+	}
+}
+
+func excessiveReplicas(input []byte, maxReplicas int32) int {
+	workload := &struct {
+		Spec struct {
+			Replicas *int32 `json:"replicas"`
+		} `json:"spec"`
+	}{}
+	err := json.Unmarshal(input, workload)
+	if err != nil {
+		return 0
+	}
+
+	if workload.Spec.Replicas != nil && *workload.Spec.Replicas > maxReplicas {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ExcessiveReplicas(t *testing.T) {
+	excessiveReplicas := NewExcessiveReplicas(5).Predicate
+
+	tests := map[int]int{
+		6: 1,
+		5: 0,
+		4: 0,
+	}
+
+	for replicas, want := range tests {
+		var data = fmt.Sprintf(`
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  replicas: %d
+  template:
+    spec:
+      containers:
+      - name: manager
+`, replicas)
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if got := excessiveReplicas(json); got != want {
+			t.Errorf("Got %v for %v replicas wanted %v", got, replicas, want)
+		}
+	}
+}
+
+func Test_ExcessiveReplicas_Unset(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if got := ExcessiveReplicas(json); got != 0 {
+		t.Errorf("Got %v wanted %v", got, 0)
+	}
+}
+
+func Test_ExcessiveReplicas_Default(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  replicas: 11
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if got := ExcessiveReplicas(json); got != 1 {
+		t.Errorf("Got %v wanted %v", got, 1)
+	}
+}
@@ -2,23 +2,19 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func ExecPodsClusterRole(input []byte) int {
+	return withPolicyRules(input, execPodsClusterRoleRules)
+}
+
+func execPodsClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
 	rbac := 0
 
 	var foundPodsGet, foundExecCreate bool
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
-
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("", rule.APIGroups) &&
 			containsAll([]string{"pods", "pods/exec"}, rule.Resources) &&
 			(contains("*", rule.Verbs) || containsAll([]string{"get", "create"}, rule.Verbs)) {
@@ -0,0 +1,19 @@
+// OPR-R85-SC - pod explicitly sets automountServiceAccountToken: true
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func ExplicitTokenAutomount(input []byte) int {
+	return withPodSpec(input, explicitTokenAutomountPodSpec)
+}
+
+func explicitTokenAutomountPodSpec(podSpec *corev1.PodSpec) int {
+	// an unset value also mounts the token, but setting it shows the token is wanted
+	if podSpec.AutomountServiceAccountToken != nil && *podSpec.AutomountServiceAccountToken {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,39 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ExplicitTokenAutomount(t *testing.T) {
+	tests := map[string]int{
+		"automountServiceAccountToken: true":  1,
+		"automountServiceAccountToken: false": 0,
+		"serviceAccountName: controller":      0,
+	}
+
+	for field, want := range tests {
+		var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      ` + field + `
+      containers:
+      - name: manager
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		automount := ExplicitTokenAutomount(json)
+		if automount != want {
+			t.Errorf("Got %v automount for %v wanted %v", automount, field, want)
+		}
+	}
+}
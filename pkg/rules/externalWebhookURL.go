@@ -0,0 +1,43 @@
+// OPR-R43-WH - webhook calls a URL outside the cluster
+package rules
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func ExternalWebhookURL(input []byte) int {
+	webhooks := 0
+
+	config := &struct {
+		Webhooks []struct {
+			ClientConfig admissionregistrationv1.WebhookClientConfig `json:"clientConfig"`
+		} `json:"webhooks"`
+	}{}
+	err := json.Unmarshal(input, config)
+	if err != nil {
+		return 0
+	}
+
+	for _, webhook := range config.Webhooks {
+		if webhook.ClientConfig.URL != nil && !isClusterServiceURL(*webhook.ClientConfig.URL) {
+			webhooks++
+		}
+	}
+
+	return webhooks
+}
+
+// isClusterServiceURL reports whether a URL addresses an in-cluster Service by DNS name
+func isClusterServiceURL(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimSuffix(u.Hostname(), ".")
+	return strings.HasSuffix(host, ".svc") || strings.HasSuffix(host, ".svc.cluster.local")
+}
@@ -0,0 +1,61 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ExternalWebhookURL_URL(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: example-operator-validating-webhook
+webhooks:
+- name: vexample.example.com
+  clientConfig:
+    url: https://webhook.example.com:8443/validate
+- name: vinternal.example.com
+  clientConfig:
+    url: https://webhook-service.operator-system.svc:443/validate
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhooks := ExternalWebhookURL(json)
+	if webhooks != 1 {
+		t.Errorf("Got %v webhooks wanted %v", webhooks, 1)
+	}
+}
+
+func Test_ExternalWebhookURL_Service(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: example-operator-mutating-webhook
+webhooks:
+- name: mexample.example.com
+  clientConfig:
+    service:
+      name: webhook-service
+      namespace: operator-system
+      path: /mutate
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhooks := ExternalWebhookURL(json)
+	if webhooks != 0 {
+		t.Errorf("Got %v webhooks wanted %v", webhooks, 0)
+	}
+}
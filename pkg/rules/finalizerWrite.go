@@ -0,0 +1,31 @@
+// OPR-R39-RBAC - ClusterRole can update finalizers
+package rules
+
+import (
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func FinalizerWriteClusterRole(input []byte) int {
+	return withPolicyRules(input, finalizerWriteClusterRoleRules)
+}
+
+func finalizerWriteClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if !containsAny([]string{"*", "update", "patch"}, rule.Verbs) {
+			continue
+		}
+
+		for _, resource := range rule.Resources {
+			if strings.HasSuffix(resource, "/finalizers") {
+				rbac++
+				break
+			}
+		}
+	}
+
+	return rbac
+}
@@ -0,0 +1,18 @@
+// OPR-R69-SC - securityContext sets a non-root fsGroup
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func FsGroup(input []byte) int {
+	return withPodSpec(input, fsGroupPodSpec)
+}
+
+func fsGroupPodSpec(podSpec *corev1.PodSpec) int {
+	if podSpec.SecurityContext != nil && podSpec.SecurityContext.FSGroup != nil && *podSpec.SecurityContext.FSGroup > 0 {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,101 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_FsGroup_Deploy(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      securityContext:
+        fsGroup: 65532
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := FsGroup(json)
+	if securityContext != 1 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 1)
+	}
+}
+
+func Test_FsGroup_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    fsGroup: 2000
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := FsGroup(json)
+	if securityContext != 1 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 1)
+	}
+}
+
+func Test_FsGroup_Root(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    fsGroup: 0
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := FsGroup(json)
+	if securityContext != 0 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
+	}
+}
+
+func Test_FsGroup_Absent(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := FsGroup(json)
+	if securityContext != 0 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
+	}
+}
@@ -0,0 +1,18 @@
+// OPR-R87-SC - DaemonSet uses the host network on every node
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func HostNetworkDaemonSet(input []byte) int {
+	return withPodSpec(input, hostNetworkDaemonSetPodSpec)
+}
+
+func hostNetworkDaemonSetPodSpec(podSpec *corev1.PodSpec) int {
+	if podSpec.HostNetwork {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,54 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_HostNetworkDaemonSet(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      hostNetwork: true
+      containers:
+      - name: agent
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	hostNetwork := HostNetworkDaemonSet(json)
+	if hostNetwork != 1 {
+		t.Errorf("Got %v hostNetwork wanted %v", hostNetwork, 1)
+	}
+}
+
+func Test_HostNetworkDaemonSet_Missing(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      containers:
+      - name: agent
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	hostNetwork := HostNetworkDaemonSet(json)
+	if hostNetwork != 0 {
+		t.Errorf("Got %v hostNetwork wanted %v", hostNetwork, 0)
+	}
+}
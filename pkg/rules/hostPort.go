@@ -0,0 +1,24 @@
+// OPR-R76-SC - container binds a hostPort
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func HostPort(input []byte) int {
+	return withPodSpec(input, hostPortPodSpec)
+}
+
+func hostPortPodSpec(podSpec *corev1.PodSpec) int {
+	ports := 0
+
+	for _, container := range allContainers(podSpec) {
+		for _, port := range container.Ports {
+			if port.HostPort != 0 {
+				ports++
+			}
+		}
+	}
+
+	return ports
+}
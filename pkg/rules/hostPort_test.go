@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_HostPort_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    ports:
+    - containerPort: 8443
+      hostPort: 8443
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ports := HostPort(json)
+	if ports != 1 {
+		t.Errorf("Got %v ports wanted %v", ports, 1)
+	}
+}
+
+func Test_HostPort_Multiple(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        ports:
+        - containerPort: 9000
+          hostPort: 9000
+      containers:
+      - name: manager
+        ports:
+        - containerPort: 8080
+          hostPort: 80
+        - containerPort: 8443
+          hostPort: 443
+        - containerPort: 9443
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ports := HostPort(json)
+	if ports != 3 {
+		t.Errorf("Got %v ports wanted %v", ports, 3)
+	}
+}
+
+func Test_HostPort_ContainerPortOnly(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        ports:
+        - containerPort: 8443
+          name: webhook
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	ports := HostPort(json)
+	if ports != 0 {
+		t.Errorf("Got %v ports wanted %v", ports, 0)
+	}
+}
@@ -0,0 +1,41 @@
+package rules
+
+import (
+	"strings"
+)
+
+// imageReference is a parsed container image reference [registry/]repository[:tag][@digest]
+type imageReference struct {
+	Registry   string
+	Repository string
+	Tag        string
+	Digest     string
+}
+
+func parseImage(image string) imageReference {
+	ref := imageReference{}
+
+	if i := strings.Index(image, "@"); i >= 0 {
+		ref.Digest = image[i+1:]
+		image = image[:i]
+	}
+
+	// the tag separator is the last colon after the last slash, a colon before it is a registry port
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		ref.Tag = image[i+1:]
+		image = image[:i]
+	}
+
+	// the first component is a registry host if it looks like one, otherwise docker.io is implied
+	if i := strings.Index(image, "/"); i >= 0 {
+		host := image[:i]
+		if strings.ContainsAny(host, ".:") || host == "localhost" {
+			ref.Registry = host
+			image = image[i+1:]
+		}
+	}
+
+	ref.Repository = image
+
+	return ref
+}
@@ -0,0 +1,53 @@
+// OPR-R65-SC - imagePullPolicy is consistent with the image reference
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ImagePullPolicy counts containers whose pull policy is consistent with their image,
+// and only passes when every container is. The matched combinations are
+//
+//	Never                              inconsistent, a node-local image is never verified
+//	Always with :latest or no tag      inconsistent, each pull may fetch a different image
+//	Always with a fixed tag or digest  consistent
+//	IfNotPresent                       consistent
+//
+// An unset policy is evaluated as the Kubernetes default: Always for :latest or no tag,
+// IfNotPresent otherwise.
+func ImagePullPolicy(input []byte) int {
+	return withPodSpec(input, imagePullPolicyPodSpec)
+}
+
+func imagePullPolicyPodSpec(podSpec *corev1.PodSpec) int {
+	containers := allContainers(podSpec)
+	for _, container := range containers {
+		if !consistentPullPolicy(container) {
+			return 0
+		}
+	}
+
+	return len(containers)
+}
+
+func consistentPullPolicy(container corev1.Container) bool {
+	ref := parseImage(container.Image)
+	mutable := ref.Digest == "" && (ref.Tag == "" || ref.Tag == "latest")
+
+	policy := container.ImagePullPolicy
+	if policy == "" {
+		policy = corev1.PullIfNotPresent
+		if mutable {
+			policy = corev1.PullAlways
+		}
+	}
+
+	switch policy {
+	case corev1.PullNever:
+		return false
+	case corev1.PullAlways:
+		return !mutable
+	}
+
+	return true
+}
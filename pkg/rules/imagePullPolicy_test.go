@@ -0,0 +1,44 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ImagePullPolicy(t *testing.T) {
+	tests := []struct {
+		image      string
+		policy     string
+		containers int
+	}{
+		{image: "controller:v1.2.3", policy: "Never", containers: 0},
+		{image: "controller:latest", policy: "Always", containers: 0},
+		{image: "controller", policy: "", containers: 0},
+		{image: "controller@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e", policy: "IfNotPresent", containers: 1},
+		{image: "controller:v1.2.3", policy: "Always", containers: 1},
+		{image: "controller:v1.2.3", policy: "", containers: 1},
+	}
+
+	for _, test := range tests {
+		var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: ` + test.image + `
+    imagePullPolicy: "` + test.policy + `"
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if containers := ImagePullPolicy(json); containers != test.containers {
+			t.Errorf("Got %v containers for %v with %q wanted %v", containers, test.image, test.policy, test.containers)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+// OPR-R62-SC - every image is pinned to a tag other than latest or a digest
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func ImageTagPinned(input []byte) int {
+	return withPodSpec(input, imageTagPinnedPodSpec)
+}
+
+func imageTagPinnedPodSpec(podSpec *corev1.PodSpec) int {
+	containers := allContainers(podSpec)
+	for _, container := range containers {
+		ref := parseImage(container.Image)
+		if ref.Digest == "" && (ref.Tag == "" || ref.Tag == "latest") {
+			return 0
+		}
+	}
+
+	return len(containers)
+}
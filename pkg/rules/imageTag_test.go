@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ImageTagPinned(t *testing.T) {
+	tests := map[string]bool{
+		"controller@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e":        true,
+		"registry.example.com:5000/operators/controller:latest@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6": true,
+		"registry.example.com:5000/operators/controller:v1.2.3":                                     true,
+		"controller:latest":                      false,
+		"controller":                             false,
+		"registry.example.com:5000/controller":   false,
+		"registry.example.com/operators/manager": false,
+	}
+
+	for image, pinned := range tests {
+		var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: ` + image + `
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if got := ImageTagPinned(json) > 0; got != pinned {
+			t.Errorf("Got pinned %v for image %v wanted %v", got, image, pinned)
+		}
+	}
+}
+
+func Test_ImageTagPinned_InitContainer(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: busybox
+      containers:
+      - name: manager
+        image: controller:v1.2.3
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ImageTagPinned(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
@@ -0,0 +1,34 @@
+// OPR-R63-RBAC - ClusterRole can impersonate any identity
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func ImpersonateAnyIdentityClusterRole(input []byte) int {
+	return withPolicyRules(input, impersonateAnyIdentityClusterRoleRules)
+}
+
+func impersonateAnyIdentityClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	found := make(map[string]bool)
+	for _, rule := range policyRules {
+		// resourceNames limit impersonation to named identities
+		if len(rule.ResourceNames) > 0 ||
+			!containsAny([]string{"", "*"}, rule.APIGroups) ||
+			!containsAny([]string{"*", "impersonate"}, rule.Verbs) {
+			continue
+		}
+
+		for _, resource := range []string{"users", "groups", "serviceaccounts"} {
+			if containsAny([]string{"*", resource}, rule.Resources) {
+				found[resource] = true
+			}
+		}
+	}
+
+	if found["users"] && found["groups"] && found["serviceaccounts"] {
+		return 1
+	}
+
+	return 0
+}
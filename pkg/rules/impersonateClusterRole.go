@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func ImpersonateClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, impersonateClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func impersonateClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if containsAny([]string{"", "*"}, rule.APIGroups) &&
 			contains("serviceaccounts", rule.Resources) &&
 			contains("impersonate", rule.Verbs) {
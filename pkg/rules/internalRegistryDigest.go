@@ -0,0 +1,41 @@
+// OPR-R99-SC - internal registry images are pinned by digest
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// InternalRegistryDigest is not applicable to any pod, the internal registries are
+// configured with NewInternalRegistryDigest
+func InternalRegistryDigest(input []byte) int {
+	return withPodSpec(input, internalRegistryDigestPodSpec(nil))
+}
+
+// NewInternalRegistryDigest returns the InternalRegistryDigest predicates, which return 1
+// when the pod runs images from the given registries and every one of them is pinned by
+// digest. Pods without internal images are not applicable.
+func NewInternalRegistryDigest(registries []string) Predicates {
+	return podSpecPredicates(internalRegistryDigestPodSpec(registries))
+}
+
+func internalRegistryDigestPodSpec(registries []string) func(*corev1.PodSpec) int {
+	return func(podSpec *corev1.PodSpec) int {
+		images := 0
+
+		for _, container := range allContainers(podSpec) {
+			if !isInternalImage(registries, container.Image) {
+				continue
+			}
+			if parseImage(container.Image).Digest == "" {
+				return 0
+			}
+			images++
+		}
+
+		if images > 0 {
+			return 1
+		}
+
+		return NotApplicable
+	}
+}
@@ -0,0 +1,44 @@
+// OPR-R40-SC - internal registry image uses a mutable tag
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"strings"
+)
+
+// InternalRegistryTagPolicy is inactive, the internal registries are configured with
+// NewInternalRegistryTagPolicy
+func InternalRegistryTagPolicy(input []byte) int {
+	return withPodSpec(input, internalRegistryTagPolicyPodSpec(nil))
+}
+
+// NewInternalRegistryTagPolicy returns the InternalRegistryTagPolicy predicates for the
+// registry hosts or repository prefixes of the internal build registry, whose images
+// must be pinned by digest
+func NewInternalRegistryTagPolicy(registries []string) Predicates {
+	return podSpecPredicates(internalRegistryTagPolicyPodSpec(registries))
+}
+
+func internalRegistryTagPolicyPodSpec(registries []string) func(*corev1.PodSpec) int {
+	return func(podSpec *corev1.PodSpec) int {
+		images := 0
+
+		for _, container := range allContainers(podSpec) {
+			if isInternalImage(registries, container.Image) && parseImage(container.Image).Digest == "" {
+				images++
+			}
+		}
+
+		return images
+	}
+}
+
+func isInternalImage(registries []string, image string) bool {
+	for _, registry := range registries {
+		registry = strings.TrimSuffix(registry, "/")
+		if registry != "" && strings.HasPrefix(image, registry+"/") {
+			return true
+		}
+	}
+	return false
+}
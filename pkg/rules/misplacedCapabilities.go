@@ -0,0 +1,22 @@
+// OPR-R46-SC - capabilities set on the pod securityContext
+package rules
+
+import (
+	"bytes"
+
+	"github.com/thedevsaddam/gojsonq/v2"
+)
+
+func MisplacedPodCapabilities(json []byte) int {
+	spec := getSpecSelector(json)
+
+	// capabilities only exist on the container securityContext, the API server drops them from the pod
+	jqCapabilities := gojsonq.New().Reader(bytes.NewReader(json)).
+		From(spec + ".securityContext.capabilities")
+
+	if jqCapabilities.Error() != nil || jqCapabilities.Get() == nil {
+		return 0
+	}
+
+	return 1
+}
@@ -0,0 +1,62 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_MisplacedPodCapabilities_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      securityContext:
+        runAsNonRoot: true
+        capabilities:
+          drop:
+          - ALL
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sc := MisplacedPodCapabilities(json)
+	if sc != 1 {
+		t.Errorf("Got %v securityContext wanted %v", sc, 1)
+	}
+}
+
+func Test_MisplacedPodCapabilities_Container(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    runAsNonRoot: true
+  containers:
+  - name: manager
+    securityContext:
+      capabilities:
+        drop:
+        - ALL
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sc := MisplacedPodCapabilities(json)
+	if sc != 0 {
+		t.Errorf("Got %v securityContext wanted %v", sc, 0)
+	}
+}
@@ -0,0 +1,29 @@
+// OPR-R95-RBAC - ClusterRole can create or modify ClusterRoleBindings
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func ModifyClusterRoleBindingsClusterRole(input []byte) int {
+	return withPolicyRules(input, modifyClusterRoleBindingsClusterRoleRules)
+}
+
+func modifyClusterRoleBindingsClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	// full permissions over clusterrolebindings are reported by StarClusterRoleAndBindings
+	if starClusterRoleAndBindingsRules(policyRules) > 0 {
+		return 0
+	}
+
+	for _, rule := range policyRules {
+		if containsAny([]string{"*", "rbac.authorization.k8s.io"}, rule.APIGroups) &&
+			containsAny([]string{"*", "clusterrolebindings"}, rule.Resources) &&
+			containsAny([]string{"*", "create", "update", "patch"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
@@ -0,0 +1,37 @@
+// OPR-R84-RBAC - role can escalate roles and create rolebindings
+package rules
+
+import (
+	"encoding/json"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// NamespacedSelfGrant matches a Role or ClusterRole that can both escalate roles and
+// create rolebindings, which together let the holder grant itself any namespaced permission
+func NamespacedSelfGrant(input []byte) int {
+	role := &rbacv1.ClusterRole{}
+	err := json.Unmarshal(input, role)
+	if err != nil {
+		return 0
+	}
+
+	var escalate, bind bool
+	for _, rule := range role.Rules {
+		if !containsAny([]string{"*", "rbac.authorization.k8s.io"}, rule.APIGroups) {
+			continue
+		}
+		if containsAny([]string{"*", "roles"}, rule.Resources) && containsAny([]string{"*", "escalate"}, rule.Verbs) {
+			escalate = true
+		}
+		if containsAny([]string{"*", "rolebindings"}, rule.Resources) && containsAny([]string{"*", "create"}, rule.Verbs) {
+			bind = true
+		}
+	}
+
+	if escalate && bind {
+		return 1
+	}
+
+	return 0
+}
@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func NetworkPolicyClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, networkPolicyClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func networkPolicyClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("networking.k8s.io", rule.APIGroups) &&
 			containsAny([]string{"networkpolicy", "networkpolicies", "*"}, rule.Resources) &&
 			containsAny([]string{"*", "create", "update", "patch", "delete", "deletecollection"}, rule.Verbs) {
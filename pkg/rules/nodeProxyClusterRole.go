@@ -2,21 +2,17 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func NodeProxyClusterRole(input []byte) int {
-	rbac := 0
+	return withPolicyRules(input, nodeProxyClusterRoleRules)
+}
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
+func nodeProxyClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
 
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("", rule.APIGroups) &&
 			contains("nodes/proxy", rule.Resources) &&
 			contains("*", rule.Verbs) {
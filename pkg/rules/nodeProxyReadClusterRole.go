@@ -0,0 +1,32 @@
+// OPR-R98-RBAC - ClusterRole can read nodes/proxy
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// NodeProxyReadClusterRole flags get or list on nodes/proxy. Grants of * or of get
+// together with create are left to NodeProxyClusterRole.
+func NodeProxyReadClusterRole(input []byte) int {
+	return withPolicyRules(input, nodeProxyReadClusterRoleRules)
+}
+
+func nodeProxyReadClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if !contains("", rule.APIGroups) || !contains("nodes/proxy", rule.Resources) {
+			continue
+		}
+
+		if contains("*", rule.Verbs) || containsAll([]string{"get", "create"}, rule.Verbs) {
+			continue
+		}
+
+		if containsAny([]string{"get", "list"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
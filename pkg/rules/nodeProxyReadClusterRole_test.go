@@ -0,0 +1,95 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_NodeProxyReadClusterRole_Get(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes
+  - nodes/proxy
+  verbs:
+  - get
+  - list
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodeProxyReadClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v NodeProxyReadClusterRole permissions wanted %v", rbac, 1)
+	}
+	if rbac := NodeProxyClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodeProxyClusterRole permissions wanted %v", rbac, 0)
+	}
+}
+
+func Test_NodeProxyReadClusterRole_Get_Create(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes/proxy
+  verbs:
+  - get
+  - create
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodeProxyReadClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodeProxyReadClusterRole permissions wanted %v", rbac, 0)
+	}
+	if rbac := NodeProxyClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v NodeProxyClusterRole permissions wanted %v", rbac, 1)
+	}
+}
+
+func Test_NodeProxyReadClusterRole_Nodes(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes
+  verbs:
+  - get
+  - list
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodeProxyReadClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodeProxyReadClusterRole permissions wanted %v", rbac, 0)
+	}
+}
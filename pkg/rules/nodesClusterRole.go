@@ -0,0 +1,24 @@
+// OPR-R58-RBAC - ClusterRole can read nodes
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// NodesClusterRole flags read access to nodes. Grants on nodes/proxy are scored by
+// NodeProxyReadClusterRole and NodeProxyClusterRole.
+func NodesClusterRole(input []byte) int {
+	return withPolicyRules(input, nodesClusterRoleRules)
+}
+
+func nodesClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	for _, rule := range policyRules {
+		if contains("", rule.APIGroups) &&
+			contains("nodes", rule.Resources) &&
+			containsAny([]string{"*", "get", "list"}, rule.Verbs) {
+			return 1
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,94 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_NodesClusterRole_Proxy_Star(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes/proxy
+  verbs:
+  - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodesClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodesClusterRole permissions wanted %v", rbac, 0)
+	}
+	if rbac := NodeProxyReadClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodeProxyReadClusterRole permissions wanted %v", rbac, 0)
+	}
+	if rbac := NodeProxyClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v NodeProxyClusterRole permissions wanted %v", rbac, 1)
+	}
+}
+
+func Test_NodesClusterRole_Get(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - nodes
+  verbs:
+  - get
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodesClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v NodesClusterRole permissions wanted %v", rbac, 1)
+	}
+	if rbac := NodeProxyClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v NodeProxyClusterRole permissions wanted %v", rbac, 0)
+	}
+}
+
+func Test_NodesClusterRole_Other_Resources(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - ""
+  resources:
+  - configmaps
+  verbs:
+  - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := NodesClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v permissions wanted %v", rbac, 0)
+	}
+}
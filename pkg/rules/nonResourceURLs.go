@@ -0,0 +1,22 @@
+// OPR-R31-RBAC - ClusterRole has access to all non-resource URLs
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func WildcardNonResourceURLs(input []byte) int {
+	return withPolicyRules(input, wildcardNonResourceURLsRules)
+}
+
+func wildcardNonResourceURLsRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if contains("*", rule.NonResourceURLs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
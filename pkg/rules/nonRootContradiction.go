@@ -0,0 +1,40 @@
+// OPR-R90-SC - securityContext sets runAsNonRoot: true with runAsUser: 0
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func NonRootWithRootUID(input []byte) int {
+	return withPodSpec(input, nonRootWithRootUIDPodSpec)
+}
+
+func nonRootWithRootUIDPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	// container settings override the pod securityContext
+	var podNonRoot *bool
+	var podUser *int64
+	if podSpec.SecurityContext != nil {
+		podNonRoot = podSpec.SecurityContext.RunAsNonRoot
+		podUser = podSpec.SecurityContext.RunAsUser
+	}
+
+	for _, container := range allContainers(podSpec) {
+		nonRoot, user := podNonRoot, podUser
+		if container.SecurityContext != nil {
+			if container.SecurityContext.RunAsNonRoot != nil {
+				nonRoot = container.SecurityContext.RunAsNonRoot
+			}
+			if container.SecurityContext.RunAsUser != nil {
+				user = container.SecurityContext.RunAsUser
+			}
+		}
+
+		if nonRoot != nil && *nonRoot && user != nil && *user == 0 {
+			containers++
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,93 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_NonRootWithRootUID_Container(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: operator
+spec:
+  template:
+    spec:
+      containers:
+      - name: c1
+        securityContext:
+          runAsNonRoot: true
+          runAsUser: 0
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := NonRootWithRootUID(json)
+	if containers != 1 {
+		t.Errorf("Got %v containers wanted %v", containers, 1)
+	}
+}
+
+func Test_NonRootWithRootUID_PodAndContainer(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  securityContext:
+    runAsNonRoot: true
+  initContainers:
+  - name: init
+    securityContext:
+      runAsUser: 0
+  containers:
+  - name: c1
+    securityContext:
+      runAsUser: 0
+      runAsNonRoot: false
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := NonRootWithRootUID(json)
+	if containers != 1 {
+		t.Errorf("Got %v containers wanted %v", containers, 1)
+	}
+}
+
+func Test_NonRootWithRootUID_HighUID(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  securityContext:
+    runAsNonRoot: true
+    runAsUser: 65532
+  containers:
+  - name: c1
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := NonRootWithRootUID(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
@@ -0,0 +1,222 @@
+package rules
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ParsedRegistry maps predicate names to variants of the Registry predicates that read a
+// document already unmarshalled into a map, so a ruleset can parse each document once and
+// share it across rules. Predicates without a parsed variant are only in Registry.
+var ParsedRegistry = map[string]func(map[string]interface{}) int{
+	"AddAllCapabilities":                   parsedPodSpecPredicate(addAllCapabilitiesPodSpec),
+	"AdmissionControllerClusterRole":       parsedPolicyRulesPredicate(admissionControllerClusterRoleRules),
+	"BindAggregateRolesClusterRole":        parsedPolicyRulesPredicate(bindAggregateRolesClusterRoleRules),
+	"BindClusterRole":                      parsedPolicyRulesPredicate(bindClusterRoleRules),
+	"CredentialInArgs":                     parsedPodSpecPredicate(credentialInArgsPodSpec),
+	"CustomResourceClusterRole":            parsedPolicyRulesPredicate(customResourceClusterRoleRules),
+	"DangerousCapabilities":                parsedPodSpecPredicate(dangerousCapabilitiesPodSpec),
+	"DeleteCollectionSensitiveClusterRole": parsedPolicyRulesPredicate(deleteCollectionSensitiveClusterRoleRules),
+	"DownwardEnvNodeInfo":                  parsedPodSpecPredicate(downwardEnvNodeInfoPodSpec),
+	"EscalateClusterRole":                  parsedPolicyRulesPredicate(escalateClusterRoleRules),
+	"EscalateRole":                         parsedPolicyRulesPredicate(escalateRoleRules),
+	"ExcessivePodDeadline":                 parsedPodSpecPredicate(excessivePodDeadlinePodSpec),
+	"ExecPodsClusterRole":                  parsedPolicyRulesPredicate(execPodsClusterRoleRules),
+	"ExecPodsRole":                         parsedPolicyRulesPredicate(execPodsClusterRoleRules),
+	"ExplicitTokenAutomount":               parsedPodSpecPredicate(explicitTokenAutomountPodSpec),
+	"FinalizerWriteClusterRole":            parsedPolicyRulesPredicate(finalizerWriteClusterRoleRules),
+	"FsGroup":                              parsedPodSpecPredicate(fsGroupPodSpec),
+	"HostNetworkDaemonSet":                 parsedPodSpecPredicate(hostNetworkDaemonSetPodSpec),
+	"HostPort":                             parsedPodSpecPredicate(hostPortPodSpec),
+	"ImagePullPolicy":                      parsedPodSpecPredicate(imagePullPolicyPodSpec),
+	"ImageTagPinned":                       parsedPodSpecPredicate(imageTagPinnedPodSpec),
+	"ImpersonateAnyIdentityClusterRole":    parsedPolicyRulesPredicate(impersonateAnyIdentityClusterRoleRules),
+	"ImpersonateClusterRole":               parsedPolicyRulesPredicate(impersonateClusterRoleRules),
+	"InternalRegistryDigest":               parsedPodSpecPredicate(internalRegistryDigestPodSpec(nil)),
+	"InternalRegistryTagPolicy":            parsedPodSpecPredicate(internalRegistryTagPolicyPodSpec(nil)),
+	"LivenessProbe":                        parsedPodSpecPredicate(livenessProbePodSpec),
+	"MemoryEmptyDirSizeLimit":              parsedPodSpecPredicate(memoryEmptyDirSizeLimitPodSpec),
+	"ModifyClusterRoleBindingsClusterRole": parsedPolicyRulesPredicate(modifyClusterRoleBindingsClusterRoleRules),
+	"ModifyPodLogsClusterRole":             parsedPolicyRulesPredicate(modifyPodLogsClusterRoleRules),
+	"NetworkPolicyClusterRole":             parsedPolicyRulesPredicate(networkPolicyClusterRoleRules),
+	"NodeProxyClusterRole":                 parsedPolicyRulesPredicate(nodeProxyClusterRoleRules),
+	"NodeProxyReadClusterRole":             parsedPolicyRulesPredicate(nodeProxyReadClusterRoleRules),
+	"NodesClusterRole":                     parsedPolicyRulesPredicate(nodesClusterRoleRules),
+	"NonRootWithRootUID":                   parsedPodSpecPredicate(nonRootWithRootUIDPodSpec),
+	"PersistentVolumeClusterRole":          parsedPolicyRulesPredicate(persistentVolumeClusterRoleRules),
+	"PlaceholderImageTag":                  parsedPodSpecPredicate(placeholderImageTagPodSpec),
+	"PodCreateArbitrarySA":                 parsedPolicyRulesPredicate(podCreateArbitrarySARules),
+	"PrivilegedSELinux":                    parsedPodSpecPredicate(privilegedSELinuxPodSpec),
+	"ProcMountUnmasked":                    parsedPodSpecPredicate(procMountUnmaskedPodSpec),
+	"QuotaWriteClusterRole":                parsedPolicyRulesPredicate(quotaWriteClusterRoleRules),
+	"ReadOnlyRootFilesystem":               parsedPodSpecPredicate(readOnlyRootFilesystemPodSpec),
+	"ReadinessProbe":                       parsedPodSpecPredicate(readinessProbePodSpec),
+	"RemoveEventsClusterRole":              parsedPolicyRulesPredicate(removeEventsClusterRoleRules),
+	"RootVolumeMount":                      parsedPodSpecPredicate(rootVolumeMountPodSpec),
+	"RunAsUser":                            parsedPodSpecPredicate(runAsUserPodSpec),
+	"SeccompProfile":                       parsedPodSpecPredicate(seccompProfilePodSpec),
+	"SecretMountedEverywhere":              parsedPodSpecPredicate(secretMountedEverywherePodSpec),
+	"SecretsClusterRole":                   parsedPolicyRulesPredicate(secretsClusterRoleRules),
+	"SecretsRole":                          parsedPolicyRulesPredicate(secretsClusterRoleRules),
+	"ServiceAccountClusterRole":            parsedPolicyRulesPredicate(serviceAccountClusterRoleRules),
+	"ServiceAccountTokenRole":              parsedPolicyRulesPredicate(serviceAccountClusterRoleRules),
+	"ShareProcessNamespace":                parsedPodSpecPredicate(shareProcessNamespacePodSpec),
+	"ShellLifecycleHook":                   parsedPodSpecPredicate(shellLifecycleHookPodSpec),
+	"StarAllClusterRole":                   parsedPolicyRulesPredicate(starAllClusterRoleRules),
+	"StarAllCoreAPIClusterRole":            parsedPolicyRulesPredicate(starAllCoreAPIClusterRoleRules),
+	"StarAllCoreAPIRole":                   parsedPolicyRulesPredicate(starAllCoreAPIClusterRoleRules),
+	"StarAllRole":                          parsedPolicyRulesPredicate(starAllClusterRoleRules),
+	"StarClusterRoleAndBindings":           parsedPolicyRulesPredicate(starClusterRoleAndBindingsRules),
+	"TTYWithoutStdin":                      parsedPodSpecPredicate(tTYWithoutStdinPodSpec),
+	"TooManyContainers":                    parsedPodSpecPredicate(tooManyContainersPodSpec(DefaultMaxContainers)),
+	"UnmountedHostPathVolume":              parsedPodSpecPredicate(unmountedHostPathVolumePodSpec),
+	"UnnamedContainer":                     parsedPodSpecPredicate(unnamedContainerPodSpec),
+	"UnqualifiedImageRegistry":             parsedPodSpecPredicate(unqualifiedImageRegistryPodSpec(false)),
+	"WebhookConfigClusterRole":             parsedPolicyRulesPredicate(webhookConfigClusterRoleRules),
+	"WildcardNonResourceURLs":              parsedPolicyRulesPredicate(wildcardNonResourceURLsRules),
+	"WildcardVerbsOnSensitiveResources":    parsedPolicyRulesPredicate(wildcardVerbsOnSensitiveResourcesRules(DefaultSensitiveResources())),
+}
+
+// LookupParsed returns the parsed variant of a registered predicate, if it has one
+func LookupParsed(name string) (func(map[string]interface{}) int, bool) {
+	predicate, ok := ParsedRegistry[name]
+	return predicate, ok
+}
+
+// Predicates are a predicate and its variant over a parsed document, as built by the
+// constructors of predicates that take a setting
+type Predicates struct {
+	Predicate       func([]byte) int
+	ParsedPredicate func(map[string]interface{}) int
+}
+
+// podSpecPredicates builds the Predicates of a pod spec predicate
+func podSpecPredicates(predicate func(*corev1.PodSpec) int) Predicates {
+	return Predicates{
+		Predicate: func(input []byte) int {
+			return withPodSpec(input, predicate)
+		},
+		ParsedPredicate: parsedPodSpecPredicate(predicate),
+	}
+}
+
+func policyRulesPredicates(predicate func([]rbacv1.PolicyRule) int) Predicates {
+	return Predicates{
+		Predicate: func(input []byte) int {
+			return withPolicyRules(input, predicate)
+		},
+		ParsedPredicate: parsedPolicyRulesPredicate(predicate),
+	}
+}
+
+// withPolicyRules evaluates a predicate on the rules of a ClusterRole or Role
+func withPolicyRules(input []byte, predicate func([]rbacv1.PolicyRule) int) int {
+	clusterRole := &rbacv1.ClusterRole{}
+	err := json.Unmarshal(input, clusterRole)
+	if err != nil {
+		return 0
+	}
+
+	return predicate(clusterRole.Rules)
+}
+
+func parsedPodSpecPredicate(predicate func(*corev1.PodSpec) int) func(map[string]interface{}) int {
+	return func(doc map[string]interface{}) int {
+		podSpec, err := parsedPodSpec(doc)
+		if err != nil {
+			return 0
+		}
+
+		return predicate(podSpec)
+	}
+}
+
+func parsedPolicyRulesPredicate(predicate func([]rbacv1.PolicyRule) int) func(map[string]interface{}) int {
+	return func(doc map[string]interface{}) int {
+		policyRules, ok := parsedPolicyRules(doc)
+		if !ok {
+			return 0
+		}
+
+		return predicate(policyRules)
+	}
+}
+
+// parsedPodSpec is getPodSpec for a parsed document
+func parsedPodSpec(doc map[string]interface{}) (*corev1.PodSpec, error) {
+	podSpec := &corev1.PodSpec{}
+
+	spec, _ := doc["spec"].(map[string]interface{})
+	if kind, _ := doc["kind"].(string); kind != "Pod" {
+		template, _ := spec["template"].(map[string]interface{})
+		spec, _ = template["spec"].(map[string]interface{})
+	}
+	if spec == nil {
+		return nil, fmt.Errorf("document has no pod spec")
+	}
+
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(spec, podSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return podSpec, nil
+}
+
+// parsedPolicyRules reads the rules of a parsed ClusterRole or Role without converting
+// the whole object, returning false if a rule is malformed
+func parsedPolicyRules(doc map[string]interface{}) ([]rbacv1.PolicyRule, bool) {
+	items, ok := doc["rules"].([]interface{})
+	if !ok {
+		return nil, doc["rules"] == nil
+	}
+
+	policyRules := make([]rbacv1.PolicyRule, len(items))
+	for i, item := range items {
+		if item == nil {
+			continue
+		}
+
+		rule, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		policyRule := &policyRules[i]
+		var verbsOK, apiGroupsOK, resourcesOK, resourceNamesOK, nonResourceURLsOK bool
+		policyRule.Verbs, verbsOK = parsedStrings(rule["verbs"])
+		policyRule.APIGroups, apiGroupsOK = parsedStrings(rule["apiGroups"])
+		policyRule.Resources, resourcesOK = parsedStrings(rule["resources"])
+		policyRule.ResourceNames, resourceNamesOK = parsedStrings(rule["resourceNames"])
+		policyRule.NonResourceURLs, nonResourceURLsOK = parsedStrings(rule["nonResourceURLs"])
+		if !verbsOK || !apiGroupsOK || !resourcesOK || !resourceNamesOK || !nonResourceURLsOK {
+			return nil, false
+		}
+	}
+
+	return policyRules, true
+}
+
+func parsedStrings(value interface{}) ([]string, bool) {
+	if value == nil {
+		return nil, true
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	values := make([]string, len(items))
+	for i, item := range items {
+		if values[i], ok = item.(string); !ok {
+			return nil, false
+		}
+	}
+
+	return values, true
+}
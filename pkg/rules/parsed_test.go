@@ -0,0 +1,177 @@
+package rules
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+// parsedFixtures are documents exercising the edges of the parsed pod spec and policy rule readers
+var parsedFixtures = []string{`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: operator
+spec:
+  securityContext:
+    runAsNonRoot: true
+    runAsUser: 0
+    fsGroup: 2000
+  initContainers:
+  - name: init
+    image: busybox
+    args: [--password=hunter2]
+  containers:
+  - name: manager
+    image: registry.example.com/controller@sha256:4e4f3bdbd2b5b2e3a2bd46ac4d1d1b9eb7c4f1e7c8d3e5f7a9b1c3d5e7f9a1b3
+    resources:
+      limits: {cpu: 500m, memory: 128Mi}
+    ports:
+    - containerPort: 8443
+      hostPort: 8443
+    securityContext:
+      privileged: true
+      procMount: Unmasked
+      capabilities:
+        add: [ALL, NET_ADMIN]
+  volumes:
+  - name: cache
+    emptyDir:
+      medium: Memory
+`, `
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: cleanup
+spec:
+  schedule: "*/5 * * * *"
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          containers:
+          - name: cleanup
+            securityContext:
+              privileged: true
+`, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: operator
+  namespace: operator-system
+rules:
+- apiGroups: ["*"]
+  resources: ["*"]
+  verbs: ["*"]
+- apiGroups: [rbac.authorization.k8s.io]
+  resources: [clusterroles]
+  resourceNames: [edit]
+  verbs: [bind, escalate]
+- nonResourceURLs: ["*"]
+  verbs: [get]
+- null
+`, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operator
+rules:
+- apiGroups: ""
+  resources: [secrets]
+  verbs: [get]
+`, `
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: operator
+`}
+
+// parseTestDocument unmarshals a JSON document for a parsed predicate
+func parseTestDocument(t *testing.T, input []byte) map[string]interface{} {
+	doc := make(map[string]interface{})
+	if err := json.Unmarshal(input, &doc); err != nil {
+		t.Fatal(err.Error())
+	}
+	return doc
+}
+
+func Test_ParsedRegistry_MatchesRegistry(t *testing.T) {
+	docs := append([]string{}, parsedFixtures...)
+
+	paths, err := filepath.Glob(filepath.Join("..", "..", "test", "asset", "*.yaml"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		docs = append(docs, strings.Split(string(data), "\n---\n")...)
+	}
+
+	for i, data := range docs {
+		input, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			continue
+		}
+
+		doc := make(map[string]interface{})
+		if json.Unmarshal(input, &doc) != nil {
+			continue
+		}
+
+		for name, parsed := range ParsedRegistry {
+			predicate, ok := Lookup(name)
+			if !ok {
+				t.Errorf("Got no registered predicate for parsed predicate %v", name)
+				continue
+			}
+
+			if got, want := parsed(doc), predicate(input); got != want {
+				t.Errorf("Got %v from parsed %v wanted %v for document %v", got, name, want, i)
+			}
+		}
+	}
+}
+
+func Benchmark_Predicates(b *testing.B) {
+	benchmarkPredicates(b, false)
+}
+
+func Benchmark_ParsedPredicates(b *testing.B) {
+	benchmarkPredicates(b, true)
+}
+
+func benchmarkPredicates(b *testing.B, parsed bool) {
+	inputs := make([][]byte, 0, len(parsedFixtures))
+	for _, data := range parsedFixtures {
+		input, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			b.Fatal(err.Error())
+		}
+		inputs = append(inputs, input)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for _, input := range inputs {
+			if parsed {
+				doc := make(map[string]interface{})
+				_ = json.Unmarshal(input, &doc)
+				for name := range ParsedRegistry {
+					_ = ParsedRegistry[name](doc)
+				}
+			} else {
+				for name := range ParsedRegistry {
+					_ = Registry[name](input)
+				}
+			}
+		}
+	}
+}
@@ -2,22 +2,18 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func PersistentVolumeClusterRole(input []byte) int {
+	return withPolicyRules(input, persistentVolumeClusterRoleRules)
+}
+
+func persistentVolumeClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
 	rbac := 0
 	var foundPV, foundPVC bool
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
-
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("", rule.APIGroups) &&
 			containsAll([]string{"persistentvolumes", "persistentvolumeclaims"}, rule.Resources) &&
 			containsAny([]string{"*", "get", "list", "create", "patch", "update", "delete", "deletecollection", "watch"}, rule.Verbs) {
@@ -0,0 +1,41 @@
+// OPR-R80-SC - image uses a placeholder or zero-version tag
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"regexp"
+)
+
+// PlaceholderImageTagPatterns are regular expressions matched case-insensitively
+// against the whole tag of each container image
+var PlaceholderImageTagPatterns = []string{`v?0(\.0)*`, "dev", "test", "todo"}
+
+func PlaceholderImageTag(input []byte) int {
+	return withPodSpec(input, placeholderImageTagPodSpec)
+}
+
+func placeholderImageTagPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	patterns := make([]*regexp.Regexp, 0, len(PlaceholderImageTagPatterns))
+	for _, pattern := range PlaceholderImageTagPatterns {
+		if re, err := regexp.Compile("(?i)^(?:" + pattern + ")$"); err == nil {
+			patterns = append(patterns, re)
+		}
+	}
+
+	for _, container := range allContainers(podSpec) {
+		tag := parseImage(container.Image).Tag
+		if tag == "" {
+			continue
+		}
+		for _, re := range patterns {
+			if re.MatchString(tag) {
+				containers++
+				break
+			}
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,46 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_PlaceholderImageTag(t *testing.T) {
+	tests := map[string]int{
+		"controller:v0":                          1,
+		"controller:0.0.0":                       1,
+		"controller:v0.0.0":                      1,
+		"registry.example.com/controller:dev":    1,
+		"registry.example.com:5000/manager:TODO": 1,
+		"controller:test":                        1,
+		"controller:v1.4.2":                      0,
+		"controller:0.1.0":                       0,
+		"controller:latest":                      0,
+		"controller":                             0,
+	}
+
+	for image, want := range tests {
+		var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: ` + image + `
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		containers := PlaceholderImageTag(json)
+		if containers != want {
+			t.Errorf("Got %v containers for %v wanted %v", containers, image, want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// OPR-R57-RBAC - ClusterRole can create pods as any ServiceAccount
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// RBAC cannot restrict the serviceAccountName of a created pod, so any cluster wide pod
+// create grant can run a pod as any ServiceAccount in any namespace
+func PodCreateArbitrarySA(input []byte) int {
+	return withPolicyRules(input, podCreateArbitrarySARules)
+}
+
+func podCreateArbitrarySARules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if containsAny([]string{"", "*"}, rule.APIGroups) &&
+			containsAny([]string{"pods", "*"}, rule.Resources) &&
+			containsAny([]string{"*", "create"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
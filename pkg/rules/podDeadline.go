@@ -0,0 +1,21 @@
+// OPR-R79-SC - pod activeDeadlineSeconds is so large it is effectively no deadline
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MaxPodActiveDeadlineSeconds is the largest pod activeDeadlineSeconds treated as a real deadline
+var MaxPodActiveDeadlineSeconds int64 = 7 * 24 * 60 * 60
+
+func ExcessivePodDeadline(input []byte) int {
+	return withPodSpec(input, excessivePodDeadlinePodSpec)
+}
+
+func excessivePodDeadlinePodSpec(podSpec *corev1.PodSpec) int {
+	if podSpec.ActiveDeadlineSeconds != nil && *podSpec.ActiveDeadlineSeconds > MaxPodActiveDeadlineSeconds {
+		return 1
+	}
+
+	return 0
+}
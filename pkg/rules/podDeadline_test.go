@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ExcessivePodDeadline_Above(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  activeDeadlineSeconds: 31536000
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	deadline := ExcessivePodDeadline(json)
+	if deadline != 1 {
+		t.Errorf("Got %v deadline wanted %v", deadline, 1)
+	}
+}
+
+func Test_ExcessivePodDeadline_Below(t *testing.T) {
+	var data = `
+---
+apiVersion: batch/v1
+kind: Job
+spec:
+  template:
+    spec:
+      activeDeadlineSeconds: 3600
+      containers:
+      - name: migrate
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	deadline := ExcessivePodDeadline(json)
+	if deadline != 0 {
+		t.Errorf("Got %v deadline wanted %v", deadline, 0)
+	}
+}
+
+func Test_ExcessivePodDeadline_Threshold(t *testing.T) {
+	var data = `
+---
+apiVersion: batch/v1
+kind: Job
+spec:
+  template:
+    spec:
+      activeDeadlineSeconds: 3600
+      containers:
+      - name: migrate
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	previous := MaxPodActiveDeadlineSeconds
+	defer func() { MaxPodActiveDeadlineSeconds = previous }()
+	MaxPodActiveDeadlineSeconds = 600
+
+	deadline := ExcessivePodDeadline(json)
+	if deadline != 1 {
+		t.Errorf("Got %v deadline wanted %v", deadline, 1)
+	}
+}
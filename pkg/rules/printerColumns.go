@@ -0,0 +1,57 @@
+// OPR-R73-CRD - CRD printer column shows a sensitive field
+package rules
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// SensitivePrinterColumnPatterns are matched case-insensitively against the JSONPath of
+// each additional printer column
+var SensitivePrinterColumnPatterns = []string{"password", "passwd", "secret", "token", "apikey", "privatekey", "credential"}
+
+type printerColumn struct {
+	JSONPath       string `json:"jsonPath"`
+	LegacyJSONPath string `json:"JSONPath"`
+}
+
+func SensitivePrinterColumns(input []byte) int {
+	columns := 0
+
+	crd := &struct {
+		Spec struct {
+			// apiextensions.k8s.io/v1beta1 defines the columns once for all versions
+			AdditionalPrinterColumns []printerColumn `json:"additionalPrinterColumns"`
+			Versions                 []struct {
+				AdditionalPrinterColumns []printerColumn `json:"additionalPrinterColumns"`
+			} `json:"versions"`
+		} `json:"spec"`
+	}{}
+	err := json.Unmarshal(input, crd)
+	if err != nil {
+		return 0
+	}
+
+	printerColumns := crd.Spec.AdditionalPrinterColumns
+	for _, version := range crd.Spec.Versions {
+		printerColumns = append(printerColumns, version.AdditionalPrinterColumns...)
+	}
+
+	for _, column := range printerColumns {
+		if isSensitiveJSONPath(column.JSONPath) || isSensitiveJSONPath(column.LegacyJSONPath) {
+			columns++
+		}
+	}
+
+	return columns
+}
+
+func isSensitiveJSONPath(jsonPath string) bool {
+	jsonPath = strings.ToLower(jsonPath)
+	for _, pattern := range SensitivePrinterColumnPatterns {
+		if pattern != "" && strings.Contains(jsonPath, strings.ToLower(pattern)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_SensitivePrinterColumns_Sensitive(t *testing.T) {
+	var data = `
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: databases.example.com
+spec:
+  group: example.com
+  names:
+    kind: Database
+    plural: databases
+  scope: Namespaced
+  versions:
+  - name: v1
+    served: true
+    storage: true
+    additionalPrinterColumns:
+    - name: Password
+      type: string
+      jsonPath: .spec.adminPassword
+    - name: Ready
+      type: string
+      jsonPath: .status.conditions[?(@.type=="Ready")].status
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	columns := SensitivePrinterColumns(json)
+	if columns != 1 {
+		t.Errorf("Got %v columns wanted %v", columns, 1)
+	}
+}
+
+func Test_SensitivePrinterColumns_Benign(t *testing.T) {
+	var data = `
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: databases.example.com
+spec:
+  group: example.com
+  names:
+    kind: Database
+    plural: databases
+  scope: Namespaced
+  additionalPrinterColumns:
+  - name: Engine
+    type: string
+    JSONPath: .spec.engine
+  - name: Age
+    type: date
+    JSONPath: .metadata.creationTimestamp
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	columns := SensitivePrinterColumns(json)
+	if columns != 0 {
+		t.Errorf("Got %v columns wanted %v", columns, 0)
+	}
+}
+
+func Test_SensitivePrinterColumns_Configured(t *testing.T) {
+	previous := SensitivePrinterColumnPatterns
+	SensitivePrinterColumnPatterns = []string{"engine"}
+	defer func() { SensitivePrinterColumnPatterns = previous }()
+
+	var data = `
+---
+apiVersion: apiextensions.k8s.io/v1beta1
+kind: CustomResourceDefinition
+metadata:
+  name: databases.example.com
+spec:
+  additionalPrinterColumns:
+  - name: Engine
+    type: string
+    JSONPath: .spec.engine
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	columns := SensitivePrinterColumns(json)
+	if columns != 1 {
+		t.Errorf("Got %v columns wanted %v", columns, 1)
+	}
+}
@@ -10,6 +10,14 @@ import (
 )
 
 func Privileged(json []byte) int {
+	sc, _ := PrivilegedDetailed(json)
+
+	return sc
+}
+
+// PrivilegedDetailed counts privileged containers like Privileged and also returns
+// how many of the matches are init containers
+func PrivilegedDetailed(json []byte) (int, int) {
 	sc := 0
 	spec := getSpecSelector(json)
 
@@ -19,6 +27,12 @@ func Privileged(json []byte) int {
 		Where("securityContext.privileged", "!=", nil).
 		Where("securityContext.privileged", "=", true)
 
+	jqInitContainers := gojsonq.New().Reader(bytes.NewReader(json)).
+		From(spec+".initContainers").
+		Where("securityContext", "!=", nil).
+		Where("securityContext.privileged", "!=", nil).
+		Where("securityContext.privileged", "=", true)
+
 	jqSecurityContext := gojsonq.New().Reader(bytes.NewReader(json)).
 		From(spec+".securityContext").
 		Where("securityContext", "!=", nil).
@@ -28,5 +42,7 @@ func Privileged(json []byte) int {
 		sc++
 	}
 
-	return jqContainers.Count() + sc
+	initContainers := jqInitContainers.Count()
+
+	return jqContainers.Count() + initContainers + sc, initContainers
 }
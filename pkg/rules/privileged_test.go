@@ -86,3 +86,68 @@ spec:
 		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
 	}
 }
+
+func Test_Privileged_InitContainers(t *testing.T) {
+	tests := map[string]struct {
+		containers     int
+		initContainers int
+	}{
+		`
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          privileged: true
+      containers:
+      - name: manager
+`: {1, 1},
+		`
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+`: {1, 0},
+		`
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          privileged: true
+      containers:
+      - name: manager
+        securityContext:
+          privileged: true
+`: {2, 1},
+	}
+
+	for data, want := range tests {
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		containers, initContainers := PrivilegedDetailed(json)
+		if containers != want.containers || initContainers != want.initContainers {
+			t.Errorf("Got %v containers and %v init containers wanted %v and %v", containers, initContainers, want.containers, want.initContainers)
+		}
+
+		if securityContext := Privileged(json); securityContext != want.containers {
+			t.Errorf("Got %v securityContext wanted %v", securityContext, want.containers)
+		}
+	}
+}
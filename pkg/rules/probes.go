@@ -0,0 +1,39 @@
+// OPR-R29-SC - livenessProbe defined
+// OPR-R30-SC - readinessProbe defined
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func LivenessProbe(input []byte) int {
+	return withPodSpec(input, livenessProbePodSpec)
+}
+
+func livenessProbePodSpec(podSpec *corev1.PodSpec) int {
+	probes := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.LivenessProbe != nil {
+			probes++
+		}
+	}
+
+	return probes
+}
+
+func ReadinessProbe(input []byte) int {
+	return withPodSpec(input, readinessProbePodSpec)
+}
+
+func readinessProbePodSpec(podSpec *corev1.PodSpec) int {
+	probes := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.ReadinessProbe != nil {
+			probes++
+		}
+	}
+
+	return probes
+}
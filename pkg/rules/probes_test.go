@@ -0,0 +1,114 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_Probes_Present(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        livenessProbe:
+          httpGet:
+            path: /healthz
+            port: 8081
+        readinessProbe:
+          httpGet:
+            path: /readyz
+            port: 8081
+      - name: proxy
+        livenessProbe:
+          tcpSocket:
+            port: 8443
+        readinessProbe:
+          tcpSocket:
+            port: 8443
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	liveness := LivenessProbe(json)
+	if liveness != 2 {
+		t.Errorf("Got %v liveness probes wanted %v", liveness, 2)
+	}
+
+	readiness := ReadinessProbe(json)
+	if readiness != 2 {
+		t.Errorf("Got %v readiness probes wanted %v", readiness, 2)
+	}
+}
+
+func Test_Probes_Missing(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    livenessProbe:
+  containers:
+  - name: manager
+    readinessProbe: null
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	liveness := LivenessProbe(json)
+	if liveness != 0 {
+		t.Errorf("Got %v liveness probes wanted %v", liveness, 0)
+	}
+
+	readiness := ReadinessProbe(json)
+	if readiness != 0 {
+		t.Errorf("Got %v readiness probes wanted %v", readiness, 0)
+	}
+}
+
+func Test_Probes_Liveness_Only(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+      containers:
+      - name: manager
+        livenessProbe:
+          httpGet:
+            path: /healthz
+            port: 8081
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	liveness := LivenessProbe(json)
+	if liveness != 1 {
+		t.Errorf("Got %v liveness probes wanted %v", liveness, 1)
+	}
+
+	readiness := ReadinessProbe(json)
+	if readiness != 0 {
+		t.Errorf("Got %v readiness probes wanted %v", readiness, 0)
+	}
+}
@@ -0,0 +1,23 @@
+// OPR-R75-SC - securityContext set to procMount: Unmasked
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func ProcMountUnmasked(input []byte) int {
+	return withPodSpec(input, procMountUnmaskedPodSpec)
+}
+
+func procMountUnmaskedPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext != nil && container.SecurityContext.ProcMount != nil &&
+			*container.SecurityContext.ProcMount == corev1.UnmaskedProcMount {
+			containers++
+		}
+	}
+
+	return containers
+}
@@ -0,0 +1,24 @@
+// OPR-R82-RBAC - ClusterRole can modify resourcequotas or limitranges
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+func QuotaWriteClusterRole(input []byte) int {
+	return withPolicyRules(input, quotaWriteClusterRoleRules)
+}
+
+func quotaWriteClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if containsAny([]string{"", "*"}, rule.APIGroups) &&
+			containsAny([]string{"*", "resourcequotas", "limitranges"}, rule.Resources) &&
+			containsAny([]string{"*", "create", "update", "patch", "delete", "deletecollection"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
@@ -4,12 +4,35 @@ package rules
 import (
 	"bytes"
 	"fmt"
+	corev1 "k8s.io/api/core/v1"
 	"strings"
 
 	"github.com/thedevsaddam/gojsonq/v2"
 )
 
-func ReadOnlyRootFilesystem(json []byte) int {
+// ReadOnlyRootFilesystem counts the init and regular containers that do not set
+// readOnlyRootFilesystem: true, a single writable container undermines the others
+func ReadOnlyRootFilesystem(input []byte) int {
+	return withPodSpec(input, readOnlyRootFilesystemPodSpec)
+}
+
+func readOnlyRootFilesystemPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext == nil || container.SecurityContext.ReadOnlyRootFilesystem == nil ||
+			!*container.SecurityContext.ReadOnlyRootFilesystem {
+			containers++
+		}
+	}
+
+	return containers
+}
+
+// ReadOnlyRootFilesystemExplicit only counts regular containers that explicitly set
+// readOnlyRootFilesystem: false, it is the behaviour of ReadOnlyRootFilesystem before
+// init containers and unset values were checked
+func ReadOnlyRootFilesystemExplicit(json []byte) int {
 	sc := 0
 	spec := getSpecSelector(json)
 
@@ -29,6 +29,11 @@ spec:
 	}
 
 	containers := ReadOnlyRootFilesystem(json)
+	if containers != 2 {
+		t.Errorf("Got %v containers wanted %v", containers, 2)
+	}
+
+	containers = ReadOnlyRootFilesystemExplicit(json)
 	if containers != 1 {
 		t.Errorf("Got %v containers wanted %v", containers, 1)
 	}
@@ -57,6 +62,11 @@ spec:
 	}
 
 	securityContext := ReadOnlyRootFilesystem(json)
+	if securityContext != 1 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 1)
+	}
+
+	securityContext = ReadOnlyRootFilesystemExplicit(json)
 	if securityContext != 0 {
 		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
 	}
@@ -119,3 +129,97 @@ spec:
 		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
 	}
 }
+
+func Test_ReadOnlyRootFilesystem_AllReadOnly(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          readOnlyRootFilesystem: true
+      containers:
+      - name: manager
+        securityContext:
+          readOnlyRootFilesystem: true
+      - name: proxy
+        securityContext:
+          readOnlyRootFilesystem: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ReadOnlyRootFilesystem(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
+
+func Test_ReadOnlyRootFilesystem_OneWritable(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        securityContext:
+          readOnlyRootFilesystem: true
+      - name: proxy
+        securityContext:
+          readOnlyRootFilesystem: false
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ReadOnlyRootFilesystem(json)
+	if containers != 1 {
+		t.Errorf("Got %v containers wanted %v", containers, 1)
+	}
+}
+
+func Test_ReadOnlyRootFilesystem_InitContainerWritable(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          readOnlyRootFilesystem: false
+      containers:
+      - name: manager
+        securityContext:
+          readOnlyRootFilesystem: true
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	containers := ReadOnlyRootFilesystem(json)
+	if containers != 1 {
+		t.Errorf("Got %v containers wanted %v", containers, 1)
+	}
+
+	containers = ReadOnlyRootFilesystemExplicit(json)
+	if containers != 0 {
+		t.Errorf("Got %v containers wanted %v", containers, 0)
+	}
+}
@@ -0,0 +1,109 @@
+package rules
+
+// Registry maps predicate names to the predicate functions of this package so
+// that rules can be referenced by name, e.g. from a custom rule definition file
+var Registry = map[string]func([]byte) int{
+	"AddAllCapabilities":                   AddAllCapabilities,
+	"AdmissionControllerClusterRole":       AdmissionControllerClusterRole,
+	"AggregatesIntoViewEdit":               AggregatesIntoViewEdit,
+	"AllowPrivilegeEscalation":             AllowPrivilegeEscalation,
+	"BindAggregateRolesClusterRole":        BindAggregateRolesClusterRole,
+	"BindClusterRole":                      BindClusterRole,
+	"BroadWebhookRules":                    BroadWebhookRules,
+	"CapSysAdmin":                          CapSysAdmin,
+	"ClusterAdmin":                         ClusterAdmin,
+	"ConcurrentPrivilegedCronJob":          ConcurrentPrivilegedCronJob,
+	"CredentialInArgs":                     CredentialInArgs,
+	"CriticalServiceAccount":               CriticalServiceAccount,
+	"CrossNamespacePVCClusterRole":         CrossNamespacePVCClusterRole,
+	"CustomResourceClusterRole":            CustomResourceClusterRole,
+	"DangerousCapabilities":                DangerousCapabilities,
+	"DefaultNamespace":                     DefaultNamespace,
+	"DefaultServiceAccountBinding":         DefaultServiceAccountBinding,
+	"DeleteCollectionSensitiveClusterRole": DeleteCollectionSensitiveClusterRole,
+	"DownwardEnvNodeInfo":                  DownwardEnvNodeInfo,
+	"EscalateClusterRole":                  EscalateClusterRole,
+	"EscalateRole":                         EscalateRole,
+	"ExcessivePodDeadline":                 ExcessivePodDeadline,
+	"ExcessiveReplicas":                    ExcessiveReplicas,
+	"ExecPodsClusterRole":                  ExecPodsClusterRole,
+	"ExecPodsRole":                         ExecPodsRole,
+	"ExplicitTokenAutomount":               ExplicitTokenAutomount,
+	"ExternalWebhookURL":                   ExternalWebhookURL,
+	"FinalizerWriteClusterRole":            FinalizerWriteClusterRole,
+	"FsGroup":                              FsGroup,
+	"HostNetworkDaemonSet":                 HostNetworkDaemonSet,
+	"HostPort":                             HostPort,
+	"ImagePullPolicy":                      ImagePullPolicy,
+	"ImageTagPinned":                       ImageTagPinned,
+	"ImpersonateAnyIdentityClusterRole":    ImpersonateAnyIdentityClusterRole,
+	"ImpersonateClusterRole":               ImpersonateClusterRole,
+	"InternalRegistryDigest":               InternalRegistryDigest,
+	"InternalRegistryTagPolicy":            InternalRegistryTagPolicy,
+	"InvalidRestartPolicy":                 InvalidRestartPolicy,
+	"KubeSystemLeasesClusterRole":          KubeSystemLeasesClusterRole,
+	"KubeSystemNamespace":                  KubeSystemNamespace,
+	"LivenessProbe":                        LivenessProbe,
+	"MemoryEmptyDirSizeLimit":              MemoryEmptyDirSizeLimit,
+	"MisplacedPodCapabilities":             MisplacedPodCapabilities,
+	"ModifyClusterRoleBindingsClusterRole": ModifyClusterRoleBindingsClusterRole,
+	"ModifyPodLogsClusterRole":             ModifyPodLogsClusterRole,
+	"NamespacedSelfGrant":                  NamespacedSelfGrant,
+	"NetworkPolicyClusterRole":             NetworkPolicyClusterRole,
+	"NoSecurityContext":                    NoSecurityContext,
+	"NodeProxyClusterRole":                 NodeProxyClusterRole,
+	"NodeProxyReadClusterRole":             NodeProxyReadClusterRole,
+	"NodesClusterRole":                     NodesClusterRole,
+	"NonRootWithRootUID":                   NonRootWithRootUID,
+	"OrderedReadyLargeStatefulSet":         OrderedReadyLargeStatefulSet,
+	"PersistentVolumeClusterRole":          PersistentVolumeClusterRole,
+	"PlaceholderImageTag":                  PlaceholderImageTag,
+	"PodCreateArbitrarySA":                 PodCreateArbitrarySA,
+	"Privileged":                           Privileged,
+	"PrivilegedSELinux":                    PrivilegedSELinux,
+	"ProcMountUnmasked":                    ProcMountUnmasked,
+	"QuotaWriteClusterRole":                QuotaWriteClusterRole,
+	"ReadOnlyRootFilesystem":               ReadOnlyRootFilesystem,
+	"ReadOnlyRootFilesystemExplicit":       ReadOnlyRootFilesystemExplicit,
+	"ReadinessProbe":                       ReadinessProbe,
+	"RemoveEventsClusterRole":              RemoveEventsClusterRole,
+	"ResourceLimits":                       ResourceLimits,
+	"RootVolumeMount":                      RootVolumeMount,
+	"RunAsGroup":                           RunAsGroup,
+	"RunAsNonRoot":                         RunAsNonRoot,
+	"RunAsUser":                            RunAsUser,
+	"SeccompProfile":                       SeccompProfile,
+	"SecretMountedEverywhere":              SecretMountedEverywhere,
+	"SecretsClusterRole":                   SecretsClusterRole,
+	"SecretsRole":                          SecretsRole,
+	"SensitivePrinterColumns":              SensitivePrinterColumns,
+	"ServiceAccountClusterRole":            ServiceAccountClusterRole,
+	"ServiceAccountTokenRole":              ServiceAccountTokenRole,
+	"ShareProcessNamespace":                ShareProcessNamespace,
+	"ShellLifecycleHook":                   ShellLifecycleHook,
+	"StarAllClusterRole":                   StarAllClusterRole,
+	"StarAllCoreAPIClusterRole":            StarAllCoreAPIClusterRole,
+	"StarAllCoreAPIRole":                   StarAllCoreAPIRole,
+	"StarAllRole":                          StarAllRole,
+	"StarClusterRoleAndBindings":           StarClusterRoleAndBindings,
+	"TTYWithoutStdin":                      TTYWithoutStdin,
+	"TooManyContainers":                    TooManyContainers,
+	"UnmountedHostPathVolume":              UnmountedHostPathVolume,
+	"UnnamedContainer":                     UnnamedContainer,
+	"UnqualifiedImageRegistry":             UnqualifiedImageRegistry,
+	"UnsafeSysctls":                        UnsafeSysctls,
+	"WebhookConfigClusterRole":             WebhookConfigClusterRole,
+	"WebhookFailOpen":                      WebhookFailOpen,
+	"WildcardNonResourceURLs":              WildcardNonResourceURLs,
+	"WildcardVerbsOnSensitiveResources":    WildcardVerbsOnSensitiveResources,
+}
+
+// NotApplicable is returned by a predicate of a positive rule when the object has
+// nothing for the rule to check, so the rule is neither passed nor advised
+const NotApplicable = -1
+
+// Lookup returns the predicate registered under name
+func Lookup(name string) (func([]byte) int, bool) {
+	predicate, ok := Registry[name]
+	return predicate, ok
+}
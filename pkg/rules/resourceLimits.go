@@ -0,0 +1,25 @@
+// OPR-R27-SC - resources.limits set for cpu and memory
+package rules
+
+import (
+	"bytes"
+
+	"github.com/thedevsaddam/gojsonq/v2"
+)
+
+func ResourceLimits(json []byte) int {
+	limits := 0
+
+	for _, containers := range getContainerSelectors(json) {
+		jqContainers := gojsonq.New().Reader(bytes.NewReader(json)).
+			From(containers).
+			Where("resources", "!=", nil).
+			Where("resources.limits", "!=", nil).
+			Where("resources.limits.cpu", "!=", nil).
+			Where("resources.limits.memory", "!=", nil)
+
+		limits += jqContainers.Count()
+	}
+
+	return limits
+}
@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ResourceLimits_Both(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+        resources:
+          limits:
+            cpu: 100m
+            memory: 64Mi
+      containers:
+      - name: manager
+        resources:
+          limits:
+            cpu: 500m
+            memory: 128Mi
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	limits := ResourceLimits(json)
+	if limits != 2 {
+		t.Errorf("Got %v limits wanted %v", limits, 2)
+	}
+}
+
+func Test_ResourceLimits_CPU_Only(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    resources:
+      limits:
+        cpu: 500m
+      requests:
+        memory: 128Mi
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	limits := ResourceLimits(json)
+	if limits != 0 {
+		t.Errorf("Got %v limits wanted %v", limits, 0)
+	}
+}
+
+func Test_ResourceLimits_Missing(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        image: controller:latest
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	limits := ResourceLimits(json)
+	if limits != 0 {
+		t.Errorf("Got %v limits wanted %v", limits, 0)
+	}
+}
@@ -0,0 +1,36 @@
+// OPR-R68-SC - long-running workload sets a restartPolicy other than Always
+package rules
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func InvalidRestartPolicy(input []byte) int {
+	object := &struct {
+		Kind string `json:"kind"`
+	}{}
+	err := json.Unmarshal(input, object)
+	if err != nil {
+		return 0
+	}
+
+	// Pods and Jobs may legitimately use Never or OnFailure
+	switch object.Kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet":
+	default:
+		return 0
+	}
+
+	podSpec, err := getPodSpec(input)
+	if err != nil {
+		return 0
+	}
+
+	if podSpec.RestartPolicy != "" && podSpec.RestartPolicy != corev1.RestartPolicyAlways {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,60 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_InvalidRestartPolicy(t *testing.T) {
+	tests := map[string]int{
+		"Never":     1,
+		"OnFailure": 1,
+		"Always":    0,
+		"":          0,
+	}
+
+	for restartPolicy, want := range tests {
+		var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      restartPolicy: "` + restartPolicy + `"
+      containers:
+      - name: manager
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if got := InvalidRestartPolicy(json); got != want {
+			t.Errorf("Got %v for restartPolicy %q wanted %v", got, restartPolicy, want)
+		}
+	}
+}
+
+func Test_InvalidRestartPolicy_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  restartPolicy: Never
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if got := InvalidRestartPolicy(json); got != 0 {
+		t.Errorf("Got %v wanted %v", got, 0)
+	}
+}
@@ -0,0 +1,55 @@
+// OPR-R48-RBAC to OPR-R51-RBAC, OPR-R56-RBAC, OPR-R96-RBAC - namespaced Role equivalents of the ClusterRole rules
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// A Role has the same rules as a ClusterRole, so the namespaced predicates share the
+// ClusterRole checks. They are registered separately so a Role can be scored lower
+// for its smaller blast radius.
+
+// OPR-R48-RBAC - Role has full permissions over all resources in its namespace
+func StarAllRole(input []byte) int {
+	return StarAllClusterRole(input)
+}
+
+// OPR-R49-RBAC - Role has full permissions over all CoreAPI resources in its namespace
+func StarAllCoreAPIRole(input []byte) int {
+	return StarAllCoreAPIClusterRole(input)
+}
+
+// OPR-R50-RBAC - Role has access to secrets in its namespace
+func SecretsRole(input []byte) int {
+	return SecretsClusterRole(input)
+}
+
+// OPR-R51-RBAC - Role can exec into Pods in its namespace
+func ExecPodsRole(input []byte) int {
+	return ExecPodsClusterRole(input)
+}
+
+// OPR-R56-RBAC - Role can create tokens for service accounts in its namespace
+func ServiceAccountTokenRole(input []byte) int {
+	return ServiceAccountClusterRole(input)
+}
+
+// OPR-R96-RBAC - Role has escalate permissions in its namespace. A Role can only grant
+// escalate on roles, so unlike the other Role rules it does not reuse the ClusterRole check.
+func EscalateRole(input []byte) int {
+	return withPolicyRules(input, escalateRoleRules)
+}
+
+func escalateRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if containsAny([]string{"*", "rbac.authorization.k8s.io"}, rule.APIGroups) &&
+			containsAny([]string{"*", "roles"}, rule.Resources) &&
+			containsAny([]string{"*", "escalate"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
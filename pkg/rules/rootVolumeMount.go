@@ -0,0 +1,25 @@
+// OPR-R59-SC - volume mounted over the container root filesystem
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func RootVolumeMount(input []byte) int {
+	return withPodSpec(input, rootVolumeMountPodSpec)
+}
+
+func rootVolumeMountPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	for _, container := range allContainers(podSpec) {
+		for _, volumeMount := range container.VolumeMounts {
+			if volumeMount.MountPath == "/" {
+				containers++
+				break
+			}
+		}
+	}
+
+	return containers
+}
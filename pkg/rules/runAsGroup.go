@@ -0,0 +1,24 @@
+// OPR-R67-SC - securityContext set to runAsGroup > 10000
+package rules
+
+import (
+	"bytes"
+
+	"github.com/thedevsaddam/gojsonq/v2"
+)
+
+func RunAsGroup(json []byte) int {
+	sc := 0
+
+	for _, containers := range getContainerSelectors(json) {
+		jqContainers := gojsonq.New().Reader(bytes.NewReader(json)).
+			From(containers).
+			Where("securityContext", "!=", nil).
+			Where("securityContext.runAsGroup", "!=", nil).
+			Where("securityContext.runAsGroup", ">", 10000)
+
+		sc += jqContainers.Count()
+	}
+
+	return sc
+}
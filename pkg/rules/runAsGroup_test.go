@@ -0,0 +1,96 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_RunAsGroup_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      runAsGroup: 10001
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := RunAsGroup(json)
+	if securityContext != 1 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 1)
+	}
+}
+
+func Test_RunAsGroup_Boundary(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: c1
+    securityContext:
+      runAsGroup: 10000
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := RunAsGroup(json)
+	if securityContext != 0 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
+	}
+}
+
+func Test_RunAsGroup_Deploy(t *testing.T) {
+	var data = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: system
+  labels:
+    control-plane: controller-manager
+spec:
+  selector:
+    matchLabels:
+      control-plane: controller-manager
+  replicas: 1
+  template:
+    metadata:
+      labels:
+        control-plane: controller-manager
+    spec:
+      initContainers:
+      - name: init
+        securityContext:
+          runAsGroup: 20000
+      containers:
+      - name: manager
+        securityContext:
+          runAsGroup: 65532
+      - name: proxy
+        securityContext:
+          runAsGroup: 1000
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := RunAsGroup(json)
+	if securityContext != 2 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 2)
+	}
+}
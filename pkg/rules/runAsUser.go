@@ -2,31 +2,29 @@
 package rules
 
 import (
-	"bytes"
-	"fmt"
-	"strings"
-
-	"github.com/thedevsaddam/gojsonq/v2"
+	corev1 "k8s.io/api/core/v1"
 )
 
-func RunAsUser(json []byte) int {
-	sc := 0
-	spec := getSpecSelector(json)
-
-	jqContainers := gojsonq.New().Reader(bytes.NewReader(json)).
-		From(spec+".containers").
-		Where("securityContext", "!=", nil).
-		Where("securityContext.runAsUser", "!=", nil).
-		Where("securityContext.runAsUser", "=", 0)
+// RunAsUser returns how many of the pod securityContext and the init and regular
+// container securityContexts set runAsUser: 0
+func RunAsUser(input []byte) int {
+	return withPodSpec(input, runAsUserPodSpec)
+}
 
-	jqSecurityContext := gojsonq.New().Reader(bytes.NewReader(json)).
-		From(spec+".securityContext").
-		Where("securityContext", "!=", nil).
-		Where("securityContext.privileged", "!=", nil)
+func runAsUserPodSpec(podSpec *corev1.PodSpec) int {
+	sc := 0
 
-	if strings.Contains(fmt.Sprintf("%v", jqSecurityContext.Get()), "runAsUser:0") {
+	if podSpec.SecurityContext != nil && podSpec.SecurityContext.RunAsUser != nil &&
+		*podSpec.SecurityContext.RunAsUser == 0 {
 		sc++
 	}
 
-	return jqContainers.Count() + sc
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext != nil && container.SecurityContext.RunAsUser != nil &&
+			*container.SecurityContext.RunAsUser == 0 {
+			sc++
+		}
+	}
+
+	return sc
 }
@@ -63,3 +63,56 @@ spec:
 		t.Errorf("Got %v securityContext wanted %v", securityContext, 1)
 	}
 }
+
+func Test_RunAsUser_Container_Level(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    securityContext:
+      runAsUser: 0
+  containers:
+  - name: manager
+    securityContext:
+      runAsUser: 0
+  - name: proxy
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := RunAsUser(json)
+	if securityContext != 2 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 2)
+	}
+}
+
+func Test_RunAsUser_NonRoot(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    runAsUser: 1000
+  containers:
+  - name: manager
+    securityContext:
+      runAsUser: 1000
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	securityContext := RunAsUser(json)
+	if securityContext != 0 {
+		t.Errorf("Got %v securityContext wanted %v", securityContext, 0)
+	}
+}
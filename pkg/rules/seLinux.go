@@ -0,0 +1,33 @@
+// OPR-R47-SC - securityContext sets a privileged SELinux type
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// PrivilegedSELinuxTypes lists the SELinux types that are not confined by the container policy
+var PrivilegedSELinuxTypes = []string{"spc_t", "unconfined_t", "container_runtime_t", "kernel_t"}
+
+func PrivilegedSELinux(input []byte) int {
+	return withPodSpec(input, privilegedSELinuxPodSpec)
+}
+
+func privilegedSELinuxPodSpec(podSpec *corev1.PodSpec) int {
+	sc := 0
+
+	if podSpec.SecurityContext != nil && privilegedSELinuxOptions(podSpec.SecurityContext.SELinuxOptions) {
+		sc++
+	}
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext != nil && privilegedSELinuxOptions(container.SecurityContext.SELinuxOptions) {
+			sc++
+		}
+	}
+
+	return sc
+}
+
+func privilegedSELinuxOptions(options *corev1.SELinuxOptions) bool {
+	return options != nil && contains(options.Type, PrivilegedSELinuxTypes)
+}
@@ -0,0 +1,92 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_PrivilegedSELinux_Privileged(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      securityContext:
+        seLinuxOptions:
+          type: spc_t
+      containers:
+      - name: agent
+        securityContext:
+          seLinuxOptions:
+            type: unconfined_t
+      - name: sidecar
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sc := PrivilegedSELinux(json)
+	if sc != 2 {
+		t.Errorf("Got %v securityContext wanted %v", sc, 2)
+	}
+}
+
+func Test_PrivilegedSELinux_Confined(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    seLinuxOptions:
+      level: s0:c123,c456
+  containers:
+  - name: manager
+    securityContext:
+      seLinuxOptions:
+        type: container_t
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sc := PrivilegedSELinux(json)
+	if sc != 0 {
+		t.Errorf("Got %v securityContext wanted %v", sc, 0)
+	}
+}
+
+func Test_PrivilegedSELinux_Configured(t *testing.T) {
+	previous := PrivilegedSELinuxTypes
+	PrivilegedSELinuxTypes = []string{"container_t"}
+	defer func() { PrivilegedSELinuxTypes = previous }()
+
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    securityContext:
+      seLinuxOptions:
+        type: container_t
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sc := PrivilegedSELinux(json)
+	if sc != 1 {
+		t.Errorf("Got %v securityContext wanted %v", sc, 1)
+	}
+}
@@ -0,0 +1,29 @@
+// OPR-R32-SC - securityContext sets a RuntimeDefault or Localhost seccompProfile
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func SeccompProfile(input []byte) int {
+	return withPodSpec(input, seccompProfilePodSpec)
+}
+
+func seccompProfilePodSpec(podSpec *corev1.PodSpec) int {
+	if podSpec.SecurityContext != nil && confinedSeccompProfile(podSpec.SecurityContext.SeccompProfile) {
+		return 1
+	}
+
+	for _, container := range allContainers(podSpec) {
+		if container.SecurityContext != nil && confinedSeccompProfile(container.SecurityContext.SeccompProfile) {
+			return 1
+		}
+	}
+
+	return 0
+}
+
+func confinedSeccompProfile(profile *corev1.SeccompProfile) bool {
+	return profile != nil &&
+		(profile.Type == corev1.SeccompProfileTypeRuntimeDefault || profile.Type == corev1.SeccompProfileTypeLocalhost)
+}
@@ -0,0 +1,52 @@
+// OPR-R83-SC - Secret is mounted into many containers of the pod
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// MaxSecretMountContainers is the largest number of containers a single Secret may be mounted into
+var MaxSecretMountContainers = 2
+
+func SecretMountedEverywhere(input []byte) int {
+	return withPodSpec(input, secretMountedEverywherePodSpec)
+}
+
+func secretMountedEverywherePodSpec(podSpec *corev1.PodSpec) int {
+	// map each volume to the secrets it projects
+	volumeSecrets := make(map[string][]string)
+	for _, volume := range podSpec.Volumes {
+		if volume.Secret != nil {
+			volumeSecrets[volume.Name] = append(volumeSecrets[volume.Name], volume.Secret.SecretName)
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					volumeSecrets[volume.Name] = append(volumeSecrets[volume.Name], source.Secret.Name)
+				}
+			}
+		}
+	}
+
+	mounts := make(map[string]int)
+	for _, container := range allContainers(podSpec) {
+		mounted := make(map[string]bool)
+		for _, volumeMount := range container.VolumeMounts {
+			for _, secret := range volumeSecrets[volumeMount.Name] {
+				if !mounted[secret] {
+					mounted[secret] = true
+					mounts[secret]++
+				}
+			}
+		}
+	}
+
+	secrets := 0
+	for _, containers := range mounts {
+		if containers > MaxSecretMountContainers {
+			secrets++
+		}
+	}
+
+	return secrets
+}
@@ -0,0 +1,118 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_SecretMountedEverywhere_AllContainers(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        volumeMounts:
+        - name: credentials
+          mountPath: /etc/credentials
+      - name: proxy
+        volumeMounts:
+        - name: credentials
+          mountPath: /etc/credentials
+      - name: metrics
+        volumeMounts:
+        - name: credentials
+          mountPath: /etc/credentials
+      volumes:
+      - name: credentials
+        secret:
+          secretName: operator-credentials
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	secrets := SecretMountedEverywhere(json)
+	if secrets != 1 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 1)
+	}
+}
+
+func Test_SecretMountedEverywhere_OneContainer(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        volumeMounts:
+        - name: credentials
+          mountPath: /etc/credentials
+      - name: proxy
+      - name: metrics
+      volumes:
+      - name: credentials
+        secret:
+          secretName: operator-credentials
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	secrets := SecretMountedEverywhere(json)
+	if secrets != 0 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 0)
+	}
+}
+
+func Test_SecretMountedEverywhere_Threshold(t *testing.T) {
+	previous := MaxSecretMountContainers
+	MaxSecretMountContainers = 1
+	defer func() { MaxSecretMountContainers = previous }()
+
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    volumeMounts:
+    - name: credentials
+      mountPath: /etc/credentials
+  - name: proxy
+    volumeMounts:
+    - name: projected
+      mountPath: /etc/projected
+  volumes:
+  - name: credentials
+    secret:
+      secretName: operator-credentials
+  - name: projected
+    projected:
+      sources:
+      - secret:
+          name: operator-credentials
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	secrets := SecretMountedEverywhere(json)
+	if secrets != 1 {
+		t.Errorf("Got %v secrets wanted %v", secrets, 1)
+	}
+}
@@ -2,14 +2,17 @@ package rules
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+
 	"github.com/thedevsaddam/gojsonq/v2"
+	corev1 "k8s.io/api/core/v1"
 )
 
-func getSpecSelector(json []byte) string {
+func getSpecSelector(input []byte) string {
 	selector := "spec.template.spec"
 
-	jq := gojsonq.New().Reader(bytes.NewReader(json)).From("kind")
+	jq := gojsonq.New().Reader(bytes.NewReader(input)).From("kind")
 	if jq.Error() != nil {
 		return selector
 	}
@@ -22,3 +25,49 @@ func getSpecSelector(json []byte) string {
 
 	return selector
 }
+
+func getContainerSelectors(input []byte) []string {
+	spec := getSpecSelector(input)
+
+	return []string{spec + ".containers", spec + ".initContainers"}
+}
+
+// getPodSpec returns the pod spec of a Pod or of a workload's pod template
+func getPodSpec(input []byte) (*corev1.PodSpec, error) {
+	jq := gojsonq.New().Reader(bytes.NewReader(input)).From(getSpecSelector(input))
+	if jq.Error() != nil {
+		return nil, jq.Error()
+	}
+
+	spec, err := json.Marshal(jq.Get())
+	if err != nil {
+		return nil, err
+	}
+
+	podSpec := &corev1.PodSpec{}
+	err = json.Unmarshal(spec, podSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	return podSpec, nil
+}
+
+// withPodSpec evaluates a predicate on the pod spec of a Pod or of a workload's pod template
+func withPodSpec(input []byte, predicate func(*corev1.PodSpec) int) int {
+	podSpec, err := getPodSpec(input)
+	if err != nil {
+		return 0
+	}
+
+	return predicate(podSpec)
+}
+
+// allContainers returns the init and regular containers of a pod spec
+func allContainers(podSpec *corev1.PodSpec) []corev1.Container {
+	containers := make([]corev1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+
+	return containers
+}
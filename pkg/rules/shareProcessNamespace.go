@@ -0,0 +1,18 @@
+// OPR-R71-SC - containers share the pod process namespace
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func ShareProcessNamespace(input []byte) int {
+	return withPodSpec(input, shareProcessNamespacePodSpec)
+}
+
+func shareProcessNamespacePodSpec(podSpec *corev1.PodSpec) int {
+	if podSpec.ShareProcessNamespace != nil && *podSpec.ShareProcessNamespace {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,147 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_ShareProcessNamespace_Pod(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  shareProcessNamespace: true
+  containers:
+  - name: manager
+  - name: proxy
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 1 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 1)
+	}
+}
+
+func Test_ShareProcessNamespace_Deploy(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      shareProcessNamespace: true
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 1 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 1)
+	}
+}
+
+func Test_ShareProcessNamespace_StatefulSet(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  template:
+    spec:
+      shareProcessNamespace: true
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 1 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 1)
+	}
+}
+
+func Test_ShareProcessNamespace_DaemonSet(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      shareProcessNamespace: true
+      containers:
+      - name: agent
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 1 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 1)
+	}
+}
+
+func Test_ShareProcessNamespace_False(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      shareProcessNamespace: false
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 0 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 0)
+	}
+}
+
+func Test_ShareProcessNamespace_Absent(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	namespaces := ShareProcessNamespace(json)
+	if namespaces != 0 {
+		t.Errorf("Got %v namespaces wanted %v", namespaces, 0)
+	}
+}
@@ -2,22 +2,18 @@
 package rules
 
 import (
-	"encoding/json"
-
 	rbacv1 "k8s.io/api/rbac/v1"
 )
 
 func StarClusterRoleAndBindings(input []byte) int {
+	return withPolicyRules(input, starClusterRoleAndBindingsRules)
+}
+
+func starClusterRoleAndBindingsRules(policyRules []rbacv1.PolicyRule) int {
 	rbac := 0
 	var foundCR, foundCRB bool
 
-	clusterRole := &rbacv1.ClusterRole{}
-	err := json.Unmarshal(input, clusterRole)
-	if err != nil {
-		return 0
-	}
-
-	for _, rule := range clusterRole.Rules {
+	for _, rule := range policyRules {
 		if contains("rbac.authorization.k8s.io", rule.APIGroups) &&
 			containsAll([]string{"clusterroles", "clusterrolebindings"}, rule.Resources) &&
 			(contains("*", rule.Verbs) || containsAll([]string{
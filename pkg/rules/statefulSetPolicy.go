@@ -0,0 +1,30 @@
+// OPR-R52-SC - large StatefulSet rolls out pods one at a time
+package rules
+
+import (
+	"encoding/json"
+
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+// LargeStatefulSetReplicas is the replica count above which an OrderedReady rollout is flagged
+var LargeStatefulSetReplicas int32 = 10
+
+func OrderedReadyLargeStatefulSet(input []byte) int {
+	statefulSet := &appsv1.StatefulSet{}
+	err := json.Unmarshal(input, statefulSet)
+	if err != nil || statefulSet.Kind != "StatefulSet" {
+		return 0
+	}
+
+	// replicas and podManagementPolicy default to 1 and OrderedReady
+	if statefulSet.Spec.Replicas == nil || *statefulSet.Spec.Replicas <= LargeStatefulSetReplicas {
+		return 0
+	}
+
+	if statefulSet.Spec.PodManagementPolicy == "" || statefulSet.Spec.PodManagementPolicy == appsv1.OrderedReadyPodManagement {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,81 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_OrderedReadyLargeStatefulSet_Large(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  replicas: 50
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	statefulSets := OrderedReadyLargeStatefulSet(json)
+	if statefulSets != 1 {
+		t.Errorf("Got %v StatefulSets wanted %v", statefulSets, 1)
+	}
+}
+
+func Test_OrderedReadyLargeStatefulSet_Parallel(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  replicas: 50
+  podManagementPolicy: Parallel
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	statefulSets := OrderedReadyLargeStatefulSet(json)
+	if statefulSets != 0 {
+		t.Errorf("Got %v StatefulSets wanted %v", statefulSets, 0)
+	}
+}
+
+func Test_OrderedReadyLargeStatefulSet_Small(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: StatefulSet
+spec:
+  replicas: 3
+  podManagementPolicy: OrderedReady
+  template:
+    spec:
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	statefulSets := OrderedReadyLargeStatefulSet(json)
+	if statefulSets != 0 {
+		t.Errorf("Got %v StatefulSets wanted %v", statefulSets, 0)
+	}
+}
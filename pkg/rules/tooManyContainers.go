@@ -0,0 +1,29 @@
+// OPR-R86-SC - pod runs more containers than allowed
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DefaultMaxContainers is the largest number of init and regular containers allowed in
+// an operator pod unless another limit is set with NewTooManyContainers
+const DefaultMaxContainers = 5
+
+func TooManyContainers(input []byte) int {
+	return withPodSpec(input, tooManyContainersPodSpec(DefaultMaxContainers))
+}
+
+// NewTooManyContainers returns the TooManyContainers predicates for the given container limit
+func NewTooManyContainers(maxContainers int) Predicates {
+	return podSpecPredicates(tooManyContainersPodSpec(maxContainers))
+}
+
+func tooManyContainersPodSpec(maxContainers int) func(*corev1.PodSpec) int {
+	return func(podSpec *corev1.PodSpec) int {
+		if len(allContainers(podSpec)) > maxContainers {
+			return 1
+		}
+
+		return 0
+	}
+}
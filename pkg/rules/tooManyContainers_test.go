@@ -0,0 +1,86 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_TooManyContainers_Under(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+      containers:
+      - name: manager
+      - name: proxy
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	pods := TooManyContainers(json)
+	if pods != 0 {
+		t.Errorf("Got %v pods wanted %v", pods, 0)
+	}
+}
+
+func Test_TooManyContainers_Over(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      initContainers:
+      - name: init
+      - name: migrate
+      containers:
+      - name: manager
+      - name: proxy
+      - name: metrics
+      - name: logs
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	pods := TooManyContainers(json)
+	if pods != 1 {
+		t.Errorf("Got %v pods wanted %v", pods, 1)
+	}
+}
+
+func Test_TooManyContainers_Threshold(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+  containers:
+  - name: manager
+  - name: proxy
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	pods := NewTooManyContainers(2).Predicate(json)
+	if pods != 1 {
+		t.Errorf("Got %v pods wanted %v", pods, 1)
+	}
+}
@@ -0,0 +1,22 @@
+// OPR-R55-SC - container allocates a tty without stdin
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TTYWithoutStdin(input []byte) int {
+	return withPodSpec(input, tTYWithoutStdinPodSpec)
+}
+
+func tTYWithoutStdinPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	for _, container := range allContainers(podSpec) {
+		if container.TTY && !container.Stdin {
+			containers++
+		}
+	}
+
+	return containers
+}
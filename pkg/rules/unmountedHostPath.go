@@ -0,0 +1,29 @@
+// OPR-R28-SC - hostPath volume defined but not mounted
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+func UnmountedHostPathVolume(input []byte) int {
+	return withPodSpec(input, unmountedHostPathVolumePodSpec)
+}
+
+func unmountedHostPathVolumePodSpec(podSpec *corev1.PodSpec) int {
+	volumes := 0
+
+	mounted := make([]string, 0)
+	for _, container := range allContainers(podSpec) {
+		for _, volumeMount := range container.VolumeMounts {
+			mounted = append(mounted, volumeMount.Name)
+		}
+	}
+
+	for _, volume := range podSpec.Volumes {
+		if volume.HostPath != nil && !contains(volume.Name, mounted) {
+			volumes++
+		}
+	}
+
+	return volumes
+}
@@ -0,0 +1,70 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_UnmountedHostPathVolume(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      containers:
+      - name: manager
+        volumeMounts:
+        - name: config
+          mountPath: /etc/config
+      volumes:
+      - name: config
+        configMap:
+          name: manager-config
+      - name: host
+        hostPath:
+          path: /var/run
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	volumes := UnmountedHostPathVolume(json)
+	if volumes != 1 {
+		t.Errorf("Got %v volumes wanted %v", volumes, 1)
+	}
+}
+
+func Test_UnmountedHostPathVolume_Mounted(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  initContainers:
+  - name: init
+    volumeMounts:
+    - name: host
+      mountPath: /host
+  containers:
+  - name: manager
+  volumes:
+  - name: host
+    hostPath:
+      path: /var/run
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	volumes := UnmountedHostPathVolume(json)
+	if volumes != 0 {
+		t.Errorf("Got %v volumes wanted %v", volumes, 0)
+	}
+}
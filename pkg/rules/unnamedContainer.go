@@ -0,0 +1,23 @@
+// OPR-R45-SC - container has no name
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"strings"
+)
+
+func UnnamedContainer(input []byte) int {
+	return withPodSpec(input, unnamedContainerPodSpec)
+}
+
+func unnamedContainerPodSpec(podSpec *corev1.PodSpec) int {
+	containers := 0
+
+	for _, container := range allContainers(podSpec) {
+		if strings.TrimSpace(container.Name) == "" {
+			containers++
+		}
+	}
+
+	return containers
+}
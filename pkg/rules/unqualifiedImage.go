@@ -0,0 +1,37 @@
+// OPR-R64-SC - image has no registry host
+package rules
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// UnqualifiedImageRegistry is inactive, the check only applies to environments that can
+// only pull from private registries and is built with NewUnqualifiedImageRegistry
+func UnqualifiedImageRegistry(input []byte) int {
+	return withPodSpec(input, unqualifiedImageRegistryPodSpec(false))
+}
+
+// NewUnqualifiedImageRegistry returns the UnqualifiedImageRegistry predicates. When
+// privateRegistryOnly is set they count images without a registry host, which resolve
+// to docker.io and fail or pull from the wrong place.
+func NewUnqualifiedImageRegistry(privateRegistryOnly bool) Predicates {
+	return podSpecPredicates(unqualifiedImageRegistryPodSpec(privateRegistryOnly))
+}
+
+func unqualifiedImageRegistryPodSpec(privateRegistryOnly bool) func(*corev1.PodSpec) int {
+	return func(podSpec *corev1.PodSpec) int {
+		if !privateRegistryOnly {
+			return 0
+		}
+
+		containers := 0
+
+		for _, container := range allContainers(podSpec) {
+			if parseImage(container.Image).Registry == "" {
+				containers++
+			}
+		}
+
+		return containers
+	}
+}
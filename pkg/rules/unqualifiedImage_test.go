@@ -0,0 +1,64 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_UnqualifiedImageRegistry(t *testing.T) {
+	predicates := NewUnqualifiedImageRegistry(true)
+
+	tests := map[string]int{
+		"nginx":                           1,
+		"library/nginx:1.25":              1,
+		"gcr.io/foo":                      0,
+		"registry.example.com:5000/foo":   0,
+		"localhost/operators/manager:dev": 0,
+	}
+
+	for image, want := range tests {
+		var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: ` + image + `
+`
+
+		json, err := yaml.YAMLToJSON([]byte(data))
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		if got := predicates.Predicate(json); got != want {
+			t.Errorf("Got %v containers for image %v wanted %v", got, image, want)
+		}
+		if got := predicates.ParsedPredicate(parseTestDocument(t, json)); got != want {
+			t.Errorf("Got %v containers from parsed predicate for image %v wanted %v", got, image, want)
+		}
+	}
+}
+
+func Test_UnqualifiedImageRegistry_Disabled(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - name: manager
+    image: nginx
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if got := UnqualifiedImageRegistry(json); got != 0 {
+		t.Errorf("Got %v containers wanted %v", got, 0)
+	}
+}
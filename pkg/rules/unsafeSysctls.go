@@ -0,0 +1,33 @@
+// OPR-R72-SC - securityContext sets unsafe sysctls
+package rules
+
+// the sysctls the kubelet allows by default, as they are namespaced and isolated per pod
+var safeSysctls = []string{
+	"kernel.shm_rmid_forced",
+	"net.ipv4.ip_local_port_range",
+	"net.ipv4.ip_local_reserved_ports",
+	"net.ipv4.ip_unprivileged_port_start",
+	"net.ipv4.ping_group_range",
+	"net.ipv4.tcp_fin_timeout",
+	"net.ipv4.tcp_keepalive_intvl",
+	"net.ipv4.tcp_keepalive_probes",
+	"net.ipv4.tcp_keepalive_time",
+	"net.ipv4.tcp_syncookies",
+}
+
+func UnsafeSysctls(input []byte) int {
+	sysctls := 0
+
+	podSpec, err := getPodSpec(input)
+	if err != nil || podSpec.SecurityContext == nil {
+		return 0
+	}
+
+	for _, sysctl := range podSpec.SecurityContext.Sysctls {
+		if !contains(sysctl.Name, safeSysctls) {
+			sysctls++
+		}
+	}
+
+	return sysctls
+}
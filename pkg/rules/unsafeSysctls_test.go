@@ -0,0 +1,90 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_UnsafeSysctls_Safe(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: Deployment
+spec:
+  template:
+    spec:
+      securityContext:
+        sysctls:
+        - name: net.ipv4.ping_group_range
+          value: "0 2147483647"
+      containers:
+      - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sysctls := UnsafeSysctls(json)
+	if sysctls != 0 {
+		t.Errorf("Got %v sysctls wanted %v", sysctls, 0)
+	}
+}
+
+func Test_UnsafeSysctls_Unsafe(t *testing.T) {
+	var data = `
+---
+apiVersion: v1
+kind: Pod
+spec:
+  securityContext:
+    sysctls:
+    - name: kernel.msgmax
+      value: "65536"
+  containers:
+  - name: manager
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sysctls := UnsafeSysctls(json)
+	if sysctls != 1 {
+		t.Errorf("Got %v sysctls wanted %v", sysctls, 1)
+	}
+}
+
+func Test_UnsafeSysctls_Mixed(t *testing.T) {
+	var data = `
+---
+apiVersion: apps/v1
+kind: DaemonSet
+spec:
+  template:
+    spec:
+      securityContext:
+        sysctls:
+        - name: net.ipv4.ip_local_port_range
+          value: "1024 65535"
+        - name: net.core.somaxconn
+          value: "1024"
+        - name: vm.max_map_count
+          value: "262144"
+      containers:
+      - name: agent
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	sysctls := UnsafeSysctls(json)
+	if sysctls != 2 {
+		t.Errorf("Got %v sysctls wanted %v", sysctls, 2)
+	}
+}
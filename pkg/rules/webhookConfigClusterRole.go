@@ -0,0 +1,26 @@
+// OPR-R60-RBAC - ClusterRole can access webhook configurations
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// WebhookConfigClusterRole flags any access to webhook configurations, including read
+// only access. Write access is also scored by AdmissionControllerClusterRole.
+func WebhookConfigClusterRole(input []byte) int {
+	return withPolicyRules(input, webhookConfigClusterRoleRules)
+}
+
+func webhookConfigClusterRoleRules(policyRules []rbacv1.PolicyRule) int {
+	rbac := 0
+
+	for _, rule := range policyRules {
+		if contains("admissionregistration.k8s.io", rule.APIGroups) &&
+			containsAny([]string{"mutatingwebhookconfigurations", "validatingwebhookconfigurations"}, rule.Resources) &&
+			containsAny([]string{"*", "get", "list", "watch", "create", "update", "patch"}, rule.Verbs) {
+			rbac++
+		}
+	}
+
+	return rbac
+}
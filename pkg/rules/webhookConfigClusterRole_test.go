@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_WebhookConfigClusterRole_Mutating_Create(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - admissionregistration.k8s.io
+  resources:
+  - mutatingwebhookconfigurations
+  verbs:
+  - create
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := WebhookConfigClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v WebhookConfigClusterRole permissions wanted %v", rbac, 1)
+	}
+	if rbac := AdmissionControllerClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v AdmissionControllerClusterRole permissions wanted %v", rbac, 1)
+	}
+}
+
+func Test_WebhookConfigClusterRole_Get(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - admissionregistration.k8s.io
+  resources:
+  - validatingwebhookconfigurations
+  verbs:
+  - get
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := WebhookConfigClusterRole(json); rbac != 1 {
+		t.Errorf("Got %v WebhookConfigClusterRole permissions wanted %v", rbac, 1)
+	}
+	if rbac := AdmissionControllerClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v AdmissionControllerClusterRole permissions wanted %v", rbac, 0)
+	}
+}
+
+func Test_WebhookConfigClusterRole_Other_API_Group(t *testing.T) {
+	var data = `
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: example-operator
+rules:
+- apiGroups:
+  - example.com
+  resources:
+  - mutatingwebhookconfigurations
+  verbs:
+  - "*"
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if rbac := WebhookConfigClusterRole(json); rbac != 0 {
+		t.Errorf("Got %v permissions wanted %v", rbac, 0)
+	}
+}
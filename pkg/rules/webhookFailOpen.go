@@ -0,0 +1,39 @@
+// OPR-R41-WH - webhook fails open
+package rules
+
+import (
+	"encoding/json"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+)
+
+func WebhookFailOpen(input []byte) int {
+	webhooks := 0
+
+	config := &struct {
+		APIVersion string `json:"apiVersion"`
+		Webhooks   []struct {
+			FailurePolicy *admissionregistrationv1.FailurePolicyType `json:"failurePolicy"`
+		} `json:"webhooks"`
+	}{}
+	err := json.Unmarshal(input, config)
+	if err != nil {
+		return 0
+	}
+
+	for _, webhook := range config.Webhooks {
+		if webhook.FailurePolicy == nil {
+			// the v1beta1 API defaults to Ignore, v1 defaults to Fail
+			if config.APIVersion == "admissionregistration.k8s.io/v1beta1" {
+				webhooks++
+			}
+			continue
+		}
+
+		if *webhook.FailurePolicy == admissionregistrationv1.Ignore {
+			webhooks++
+		}
+	}
+
+	return webhooks
+}
@@ -0,0 +1,78 @@
+package rules
+
+import (
+	"testing"
+
+	"github.com/ghodss/yaml"
+)
+
+func Test_WebhookFailOpen_Ignore(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: example-operator-validating-webhook
+webhooks:
+- name: vexample.example.com
+  failurePolicy: Ignore
+- name: vother.example.com
+  failurePolicy: Fail
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhooks := WebhookFailOpen(json)
+	if webhooks != 1 {
+		t.Errorf("Got %v webhooks wanted %v", webhooks, 1)
+	}
+}
+
+func Test_WebhookFailOpen_Fail(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: MutatingWebhookConfiguration
+metadata:
+  name: example-operator-mutating-webhook
+webhooks:
+- name: mexample.example.com
+  failurePolicy: Fail
+- name: mdefault.example.com
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhooks := WebhookFailOpen(json)
+	if webhooks != 0 {
+		t.Errorf("Got %v webhooks wanted %v", webhooks, 0)
+	}
+}
+
+func Test_WebhookFailOpen_V1beta1_Default(t *testing.T) {
+	var data = `
+---
+apiVersion: admissionregistration.k8s.io/v1beta1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: example-operator-validating-webhook
+webhooks:
+- name: vexample.example.com
+`
+
+	json, err := yaml.YAMLToJSON([]byte(data))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	webhooks := WebhookFailOpen(json)
+	if webhooks != 1 {
+		t.Errorf("Got %v webhooks wanted %v", webhooks, 1)
+	}
+}
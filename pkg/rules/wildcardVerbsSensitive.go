@@ -0,0 +1,40 @@
+// OPR-R97-RBAC - ClusterRole or Role grants all verbs on sensitive resources
+package rules
+
+import (
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// DefaultSensitiveResources returns the core API resources on which a role must not
+// grant all verbs unless others are set with NewWildcardVerbsOnSensitiveResources
+func DefaultSensitiveResources() []string {
+	return []string{"secrets", "configmaps", "serviceaccounts"}
+}
+
+// WildcardVerbsOnSensitiveResources returns how many rules of a ClusterRole or Role grant
+// the * verb on a sensitive resource
+func WildcardVerbsOnSensitiveResources(input []byte) int {
+	return withPolicyRules(input, wildcardVerbsOnSensitiveResourcesRules(DefaultSensitiveResources()))
+}
+
+// NewWildcardVerbsOnSensitiveResources returns the WildcardVerbsOnSensitiveResources
+// predicates for the given core API resources
+func NewWildcardVerbsOnSensitiveResources(resources []string) Predicates {
+	return policyRulesPredicates(wildcardVerbsOnSensitiveResourcesRules(resources))
+}
+
+func wildcardVerbsOnSensitiveResourcesRules(resources []string) func([]rbacv1.PolicyRule) int {
+	return func(policyRules []rbacv1.PolicyRule) int {
+		rbac := 0
+
+		for _, rule := range policyRules {
+			if containsAny([]string{"", "*"}, rule.APIGroups) &&
+				containsAny(resources, rule.Resources) &&
+				contains("*", rule.Verbs) {
+				rbac++
+			}
+		}
+
+		return rbac
+	}
+}
@@ -39,9 +39,10 @@ teardown() {
 }
 
 # Dedicated NS
+# OPR-R29-SC, OPR-R30-SC - liveness and readiness probes score above zero
 @test "passes dedicated namespace defined (kind: Deployment)" {
   run _app "${TEST_DIR}/asset/deploy-ns-dedicated.yaml"
-  assert_zero_points
+  assert_gt_zero_points
 }
 
 # Dedicated NS
@@ -73,21 +74,25 @@ teardown() {
 # ------------------------------------#
 
 # All securityContexts
+# OPR-R67-SC - runAsGroup above 10000 scores above zero
 @test "passes all security contexts defined" {
   run _app "${TEST_DIR}/asset/deploy-sc-both-all.yaml"
-  assert_zero_points
+  assert_gt_zero_points
 }
 
 # All securityContexts under containers
+# OPR-R27-SC - resource limits score above zero
 @test "passes all security contexts defined under containers" {
   run _app "${TEST_DIR}/asset/deploy-sc-containers-all.yaml"
-  assert_zero_points
+  assert_gt_zero_points
 }
 
 # All securityContexts under spec
-@test "passes all security contexts defined under spec" {
+# OPR-R6-SC, OPR-R46-SC - readOnlyRootFilesystem and capabilities are container
+# settings and are ignored on the pod
+@test "fails all security contexts defined under spec" {
   run _app "${TEST_DIR}/asset/deploy-sc-spec-all.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # OPR-R3-SC
@@ -213,9 +218,10 @@ teardown() {
 }
 
 # CoreAPI Limited Resources
-@test "passes ClusterRole has access to CoreAPI with limited resources defined" {
+# OPR-R97-RBAC - all verbs on configmaps
+@test "fails ClusterRole has all verbs on CoreAPI with limited resources defined" {
   run _app "${TEST_DIR}/asset/cr-coreapi-limited-resources.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # CoreAPI Limited verbs
@@ -255,9 +261,10 @@ teardown() {
 }
 
 # Only full access to ClusterRoleBindings
-@test "passes ClusterRole only has full access to ClusterRoleBindings" {
+# OPR-R95-RBAC - binding any ClusterRole cluster-wide is enough to escalate
+@test "fails ClusterRole only has full access to ClusterRoleBindings" {
   run _app "${TEST_DIR}/asset/cr-all-clusterrolebindings-only.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # OPR-R13-RBAC
@@ -291,16 +298,16 @@ teardown() {
   assert_lt_zero_points
 }
 
-# OPR-R15-RBAC
-@test "passes ClusterRole has full access to pods (star)" {
+# OPR-R15-RBAC, OPR-R57-RBAC
+@test "fails ClusterRole has full access to pods (star)" {
   run _app "${TEST_DIR}/asset/cr-pods-star.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
-# OPR-R15-RBAC
-@test "passes ClusterRole only has get and create permissions on pods (verbs)" {
+# OPR-R15-RBAC, OPR-R57-RBAC
+@test "fails ClusterRole only has get and create permissions on pods (verbs)" {
   run _app "${TEST_DIR}/asset/cr-pods-verbs.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # OPR-R15-RBAC
@@ -471,10 +478,10 @@ teardown() {
   assert_zero_points
 }
 
-# OPR-R23-RBAC
-@test "passes ClusterRole has full permissions over service accounts (star)" {
+# OPR-R23-RBAC, OPR-R97-RBAC
+@test "fails ClusterRole has full permissions over service accounts (star)" {
   run _app "${TEST_DIR}/asset/cr-sa-star.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # OPR-R23-RBAC
@@ -561,16 +568,16 @@ teardown() {
   assert_zero_points
 }
 
-# OPR-R26-RBAC
-@test "passes ClusterRole has full ermissions over node (star)" {
+# OPR-R26-RBAC, OPR-R58-RBAC
+@test "fails ClusterRole has full ermissions over node (star)" {
   run _app "${TEST_DIR}/asset/cr-node-star.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
-# OPR-R26-RBAC
-@test "passes ClusterRole has full permissions over node (verbs)" {
+# OPR-R26-RBAC, OPR-R58-RBAC
+@test "fails ClusterRole has full permissions over node (verbs)" {
   run _app "${TEST_DIR}/asset/cr-node-verbs.yaml"
-  assert_zero_points
+  assert_lt_zero_points
 }
 
 # OPR-R26-RBAC
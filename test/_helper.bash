@@ -40,6 +40,11 @@ assert_lt_zero_points() {
   assert_failure
 }
 
+assert_gt_zero_points() {
+  assert_output --regexp ".*with a score of [1-9][0-9]* points.*"
+  assert_success
+}
+
 assert_file_not_found() {
   assert_output --regexp ".*File somefile.yaml does not exist.*" \
     || assert_output --regexp ".*no such file or directory.*"  \